@@ -13,6 +13,7 @@ import (
 
 	"atlassian-dc-mcp-go/internal/config"
 	"atlassian-dc-mcp-go/internal/mcp"
+	"atlassian-dc-mcp-go/internal/tracing"
 	"atlassian-dc-mcp-go/internal/utils/logging"
 
 	"go.uber.org/zap"
@@ -32,7 +33,7 @@ func main() {
 	help := flag.Bool("h", false, "Show help message")
 	flag.BoolVar(help, "help", false, "Show help message")
 	versionFlag := flag.Bool("version", false, "Show version information")
-	authMode := flag.String("auth-mode", "config", "Authentication mode. One of: config, header")
+	authMode := flag.String("auth-mode", "config", "Authentication mode. One of: config, header, oauth")
 	flag.Parse()
 
 	if *help {
@@ -64,14 +65,28 @@ func main() {
 		_ = logger.Sync()
 	}()
 
+	// Initialize the audit log of write operations
+	logging.InitAuditLogger(&cfg.Logging.Audit)
+	defer logging.SyncAudit()
+
 	logger.Info("Configuration loaded successfully",
 		zap.String("version", version),
 		zap.String("commit", commit),
 		zap.String("date", date))
 
-	config.WatchConfigOnChange(func() {
-		logger.Info("Configuration reloaded")
-	}, *authMode)
+	// Initialize tracing; changes to the tracing configuration require a restart
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Tracing, version)
+	if err != nil {
+		logger.Fatal("Failed to initialize tracing", zap.Error(err))
+	}
+	defer func() {
+		// Flushes any buffered spans
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Warn("Failed to flush traces", zap.Error(err))
+		}
+	}()
 
 	mcpServer := mcp.NewServer(cfg, *authMode, version)
 
@@ -79,6 +94,14 @@ func main() {
 		logger.Fatal("Failed to initialize MCP server", zap.Error(err))
 	}
 
+	config.WatchConfigOnChange(func(newCfg *config.Config) {
+		if err := mcpServer.Reload(newCfg); err != nil {
+			logger.Error("Failed to apply reloaded configuration", zap.Error(err))
+			return
+		}
+		logger.Info("Configuration reloaded")
+	}, *authMode)
+
 	logger.Info("Atlassian Data Center MCP (Model Context Protocol) server starting...",
 		zap.String("version", version))
 
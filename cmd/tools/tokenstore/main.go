@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"atlassian-dc-mcp-go/internal/auth"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Manage the encrypted token store used by the oauth auth mode\n\n")
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "  tokenstore genkey > store.key\n")
+	fmt.Fprintf(os.Stderr, "  tokenstore encrypt -key store.key < tokens.json > tokens.enc\n")
+	fmt.Fprintf(os.Stderr, "  tokenstore decrypt -key store.key < tokens.enc > tokens.json\n\n")
+	fmt.Fprintf(os.Stderr, "The plaintext store maps token subjects to Atlassian personal access tokens:\n")
+	fmt.Fprintf(os.Stderr, "  {\"alice\": {\"jira\": \"...\", \"confluence\": \"...\", \"bitbucket\": \"...\"}}\n")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	command := os.Args[1]
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	keyFile := flags.String("key", "", "Path to the base64 encoded 256-bit key")
+	_ = flags.Parse(os.Args[2:])
+
+	switch command {
+	case "genkey":
+		key, err := auth.GenerateKey()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to generate key: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(key)
+	case "encrypt", "decrypt":
+		if *keyFile == "" {
+			fmt.Fprintf(os.Stderr, "The -key flag is required\n")
+			os.Exit(2)
+		}
+
+		key, err := auth.LoadKey(*keyFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+
+		input, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to read input: %v\n", err)
+			os.Exit(1)
+		}
+
+		var output []byte
+		if command == "encrypt" {
+			// Validate the plaintext before encrypting it
+			var users map[string]auth.UserTokens
+			if err := json.Unmarshal(input, &users); err != nil {
+				fmt.Fprintf(os.Stderr, "Invalid token store JSON: %v\n", err)
+				os.Exit(1)
+			}
+			output, err = auth.EncryptTokenStore(key, input)
+		} else {
+			output, err = auth.DecryptTokenStore(key, input)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+
+		_, _ = os.Stdout.Write(output)
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
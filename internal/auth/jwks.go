@@ -0,0 +1,263 @@
+// Package auth provides OAuth 2.0 resource server support: verification of
+// bearer JWTs against a JSON Web Key Set and an encrypted store of per-user
+// Atlassian tokens.
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// jwksRefreshInterval limits how often the key set is re-fetched for unknown key IDs
+const jwksRefreshInterval = time.Minute
+
+// jwk represents a single JSON Web Key as defined in RFC 7517
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keySet caches the public keys of a JSON Web Key Set and refreshes them
+// when a token refers to an unknown key ID.
+type keySet struct {
+	fetch func(ctx context.Context) ([]byte, error)
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	lastFetch time.Time
+}
+
+// newFileKeySet creates a key set that is read from a local JWKS file
+func newFileKeySet(path string) *keySet {
+	return &keySet{
+		fetch: func(ctx context.Context) ([]byte, error) {
+			return os.ReadFile(path)
+		},
+	}
+}
+
+// newIssuerKeySet creates a key set that is discovered from the issuer's
+// OpenID Connect or OAuth 2.0 authorization server metadata
+func newIssuerKeySet(issuer string, httpClient *http.Client) *keySet {
+	return &keySet{
+		fetch: func(ctx context.Context) ([]byte, error) {
+			jwksURI, err := discoverJWKSURI(ctx, httpClient, issuer)
+			if err != nil {
+				return nil, err
+			}
+			return httpGet(ctx, httpClient, jwksURI)
+		},
+	}
+}
+
+// key returns the public key for the given key ID, refreshing the key set if needed
+func (k *keySet) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+
+	if time.Since(k.lastFetch) < jwksRefreshInterval {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	data, err := k.fetch(ctx)
+	k.lastFetch = time.Now()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load key set: %w", err)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+	k.keys = keys
+
+	if key, ok := k.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// lookup finds a key by ID. Tokens without a key ID match a key set with a single key.
+func (k *keySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if key, ok := k.keys[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+// parseJWKS parses the signing keys of a JSON Web Key Set
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to decode key set: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("key set contains no signing keys")
+	}
+
+	return keys, nil
+}
+
+// publicKey converts the JSON Web Key into a crypto.PublicKey
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) > size || len(y) > size {
+			return nil, fmt.Errorf("invalid coordinate size for curve %q", k.Crv)
+		}
+		point := make([]byte, 1+2*size)
+		point[0] = 4
+		copy(point[1+size-len(x):1+size], x)
+		copy(point[1+2*size-len(y):], y)
+		return ecdsa.ParseUncompressedPublicKey(curve, point)
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key size %d", len(x))
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// decodeBigInt decodes a base64url encoded unsigned big-endian integer
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// discoverJWKSURI reads the jwks_uri from the issuer's metadata documents
+func discoverJWKSURI(ctx context.Context, httpClient *http.Client, issuer string) (string, error) {
+	base := strings.TrimSuffix(issuer, "/")
+	var lastErr error
+
+	for _, path := range []string{"/.well-known/openid-configuration", "/.well-known/oauth-authorization-server"} {
+		data, err := httpGet(ctx, httpClient, base+path)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		var meta struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := json.Unmarshal(data, &meta); err != nil {
+			lastErr = fmt.Errorf("failed to decode issuer metadata: %w", err)
+			continue
+		}
+		if meta.JWKSURI != "" {
+			return meta.JWKSURI, nil
+		}
+		lastErr = fmt.Errorf("issuer metadata at %s has no jwks_uri", base+path)
+	}
+
+	return "", lastErr
+}
+
+// httpGet fetches a JSON document
+func httpGet(ctx context.Context, httpClient *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s failed with status %d", url, resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+}
@@ -0,0 +1,246 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"atlassian-dc-mcp-go/internal/config"
+)
+
+// clockSkew is the tolerance applied to time-based claims
+const clockSkew = time.Minute
+
+// ErrInvalidToken is returned when a token cannot be verified
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims holds the verified claims of a bearer JWT
+type Claims struct {
+	Subject   string
+	Issuer    string
+	Audience  []string
+	Scopes    []string
+	ExpiresAt time.Time
+}
+
+// rawClaims is the JSON representation of the registered claims we use
+type rawClaims struct {
+	Subject   string          `json:"sub"`
+	Issuer    string          `json:"iss"`
+	Audience  json.RawMessage `json:"aud"`
+	ExpiresAt *float64        `json:"exp"`
+	NotBefore *float64        `json:"nbf"`
+	Scope     string          `json:"scope"`
+	Scp       []string        `json:"scp"`
+}
+
+// Verifier validates OAuth 2.0 / OIDC bearer JWTs
+type Verifier struct {
+	issuer   string
+	audience string
+	keys     *keySet
+}
+
+// NewVerifier creates a Verifier for the OAuth configuration. Keys are read from
+// the configured JWKS file, or discovered from the issuer's metadata otherwise.
+func NewVerifier(cfg config.OAuthConfig) *Verifier {
+	var keys *keySet
+	if cfg.JWKSFile != "" {
+		keys = newFileKeySet(cfg.JWKSFile)
+	} else {
+		keys = newIssuerKeySet(cfg.Issuer, &http.Client{Timeout: 10 * time.Second})
+	}
+
+	audience := cfg.Audience
+	if audience == "" {
+		audience = cfg.ResourceURL
+	}
+
+	return &Verifier{
+		issuer:   cfg.Issuer,
+		audience: audience,
+		keys:     keys,
+	}
+}
+
+// Verify checks the signature and the registered claims of the token.
+// Errors unwrap to ErrInvalidToken when the token itself is not acceptable.
+func (v *Verifier) Verify(ctx context.Context, token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: invalid header: %v", ErrInvalidToken, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid signature encoding", ErrInvalidToken)
+	}
+
+	key, err := v.keys.key(ctx, header.Kid)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if err := verifySignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	var raw rawClaims
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return nil, fmt.Errorf("%w: invalid claims: %v", ErrInvalidToken, err)
+	}
+
+	return v.validateClaims(raw)
+}
+
+// validateClaims checks issuer, audience and validity period
+func (v *Verifier) validateClaims(raw rawClaims) (*Claims, error) {
+	now := time.Now()
+
+	if raw.ExpiresAt == nil {
+		return nil, fmt.Errorf("%w: missing exp claim", ErrInvalidToken)
+	}
+	expiresAt := time.Unix(int64(*raw.ExpiresAt), 0)
+	if now.After(expiresAt.Add(clockSkew)) {
+		return nil, fmt.Errorf("%w: token expired", ErrInvalidToken)
+	}
+
+	if raw.NotBefore != nil && now.Add(clockSkew).Before(time.Unix(int64(*raw.NotBefore), 0)) {
+		return nil, fmt.Errorf("%w: token not yet valid", ErrInvalidToken)
+	}
+
+	if v.issuer != "" && strings.TrimSuffix(raw.Issuer, "/") != strings.TrimSuffix(v.issuer, "/") {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, raw.Issuer)
+	}
+
+	audience, err := decodeAudience(raw.Audience)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if v.audience != "" && !slices.Contains(audience, v.audience) {
+		return nil, fmt.Errorf("%w: token is not intended for this resource", ErrInvalidToken)
+	}
+
+	if raw.Subject == "" {
+		return nil, fmt.Errorf("%w: missing sub claim", ErrInvalidToken)
+	}
+
+	scopes := raw.Scp
+	if raw.Scope != "" {
+		scopes = strings.Fields(raw.Scope)
+	}
+
+	return &Claims{
+		Subject:   raw.Subject,
+		Issuer:    raw.Issuer,
+		Audience:  audience,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// decodeSegment decodes a base64url encoded JSON segment of a JWT
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// decodeAudience accepts the aud claim as a single string or an array of strings
+func decodeAudience(data json.RawMessage) ([]string, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		return []string{single}, nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return nil, fmt.Errorf("invalid aud claim")
+	}
+	return multiple, nil
+}
+
+// verifySignature verifies the JWS signature for the given algorithm and key
+func verifySignature(alg string, key crypto.PublicKey, signed, signature []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "PS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "PS512", "ES512":
+		hash = crypto.SHA512
+	case "EdDSA":
+		pub, ok := key.(ed25519.PublicKey)
+		if !ok || !ed25519.Verify(pub, signed, signature) {
+			return fmt.Errorf("signature verification failed")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	switch alg[0] {
+	case 'R':
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key type does not match algorithm %q", alg)
+		}
+		if err := rsa.VerifyPKCS1v15(pub, hash, digest, signature); err != nil {
+			return fmt.Errorf("signature verification failed")
+		}
+	case 'P':
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key type does not match algorithm %q", alg)
+		}
+		if err := rsa.VerifyPSS(pub, hash, digest, signature, nil); err != nil {
+			return fmt.Errorf("signature verification failed")
+		}
+	case 'E':
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key type does not match algorithm %q", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return fmt.Errorf("invalid signature size")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return fmt.Errorf("signature verification failed")
+		}
+	}
+
+	return nil
+}
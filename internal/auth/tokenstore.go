@@ -0,0 +1,173 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UserTokens holds the Atlassian personal access tokens of a single user
+type UserTokens struct {
+	Jira       string `json:"jira,omitempty"`
+	Confluence string `json:"confluence,omitempty"`
+	Bitbucket  string `json:"bitbucket,omitempty"`
+}
+
+// TokenStore maps OAuth subjects to per-user Atlassian tokens.
+//
+// The store is a JSON object keyed by subject, encrypted with AES-256-GCM.
+// The file is re-read when its modification time changes, so users can be
+// added or revoked without restarting the server.
+type TokenStore struct {
+	path string
+	key  []byte
+
+	mu      sync.Mutex
+	modTime time.Time
+	users   map[string]UserTokens
+}
+
+// NewTokenStore opens the encrypted token store at path using the key read from keyFile.
+func NewTokenStore(path, keyFile string) (*TokenStore, error) {
+	key, err := LoadKey(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	store := &TokenStore{
+		path: path,
+		key:  key,
+	}
+
+	// Load once up front so that configuration errors surface at startup
+	if err := store.refresh(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Lookup returns the tokens of the given subject
+func (s *TokenStore) Lookup(subject string) (UserTokens, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.refresh(); err != nil {
+		return UserTokens{}, false, err
+	}
+
+	tokens, ok := s.users[subject]
+	return tokens, ok, nil
+}
+
+// refresh reloads the store if the file changed. The caller must hold s.mu
+// unless the store is not shared yet.
+func (s *TokenStore) refresh() error {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to stat token store: %w", err)
+	}
+
+	if s.users != nil && info.ModTime().Equal(s.modTime) {
+		return nil
+	}
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("failed to read token store: %w", err)
+	}
+
+	plaintext, err := DecryptTokenStore(s.key, data)
+	if err != nil {
+		return err
+	}
+
+	users := make(map[string]UserTokens)
+	if err := json.Unmarshal(plaintext, &users); err != nil {
+		return fmt.Errorf("failed to decode token store: %w", err)
+	}
+
+	s.users = users
+	s.modTime = info.ModTime()
+	return nil
+}
+
+// LoadKey reads a base64 encoded 256-bit key from a file
+func LoadKey(keyFile string) ([]byte, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token store key: %w", err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode token store key: %w", err)
+	}
+
+	if len(key) != 32 {
+		return nil, fmt.Errorf("token store key must be 32 bytes, got %d", len(key))
+	}
+
+	return key, nil
+}
+
+// GenerateKey returns a new random base64 encoded 256-bit key
+func GenerateKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// EncryptTokenStore encrypts the plaintext store with AES-256-GCM.
+// The random nonce is prepended to the ciphertext.
+func EncryptTokenStore(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// DecryptTokenStore decrypts data produced by EncryptTokenStore
+func DecryptTokenStore(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("token store is too short")
+	}
+
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt token store: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// newGCM creates an AES-GCM cipher for the key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -1,23 +1,69 @@
 package client
 
 import (
+	"encoding/base64"
 	"net/http"
+
+	"atlassian-dc-mcp-go/internal/config"
 )
 
 // contextKey defines a type for context keys to avoid collisions.
 type ContextKey string
 
-// Context keys for passing tokens.
+// Context keys for passing credentials.
 var (
 	BitbucketTokenKey  = ContextKey("bitbucket_token")
 	JiraTokenKey       = ContextKey("jira_token")
 	ConfluenceTokenKey = ContextKey("confluence_token")
 )
 
-// TokenAuthTransport is an http.RoundTripper that adds an Authorization header
-// with a Bearer token read from the request's context.
+// Credentials holds the authentication headers sent to an Atlassian service.
+// Each field is sent verbatim when it is not empty.
+type Credentials struct {
+	// Authorization is the value of the Authorization header, e.g. "Bearer <token>" or "Basic <base64>".
+	Authorization string
+	// Cookie is the value of the Cookie header, e.g. "JSESSIONID=<session>".
+	Cookie string
+}
+
+// IsEmpty reports whether no credentials are set.
+func (c Credentials) IsEmpty() bool {
+	return c.Authorization == "" && c.Cookie == ""
+}
+
+// BearerCredentials returns credentials for a bearer token such as a personal access token.
+func BearerCredentials(token string) Credentials {
+	if token == "" {
+		return Credentials{}
+	}
+	return Credentials{Authorization: "Bearer " + token}
+}
+
+// BasicCredentials returns credentials for HTTP Basic authentication.
+func BasicCredentials(username, password string) Credentials {
+	if username == "" {
+		return Credentials{}
+	}
+	auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return Credentials{Authorization: "Basic " + auth}
+}
+
+// CredentialsFromConfig builds the credentials for the configured authentication type.
+func CredentialsFromConfig(cfg config.ClientConfig) Credentials {
+	switch cfg.AuthType {
+	case config.AuthTypeBasic:
+		return BasicCredentials(cfg.Username, cfg.Password)
+	case config.AuthTypeCookie:
+		return Credentials{Cookie: cfg.Cookie}
+	default:
+		return BearerCredentials(cfg.Token)
+	}
+}
+
+// TokenAuthTransport is an http.RoundTripper that adds the authentication headers
+// of the Credentials read from the request's context.
 type TokenAuthTransport struct {
-	// TokenKey is the key used to look up the token in the context.
+	// TokenKey is the key used to look up the credentials in the context.
 	TokenKey ContextKey
 	// Transport is the underlying http.RoundTripper to be called after adding the header.
 	// If nil, http.DefaultTransport is used.
@@ -26,15 +72,20 @@ type TokenAuthTransport struct {
 
 // RoundTrip implements the http.RoundTripper interface.
 func (t *TokenAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	token, ok := req.Context().Value(t.TokenKey).(string)
-	if !ok || token == "" {
-		// If no token is found, proceed without an Authorization header.
+	creds, ok := req.Context().Value(t.TokenKey).(Credentials)
+	if !ok || creds.IsEmpty() {
+		// If no credentials are found, proceed without authentication headers.
 		return t.transport().RoundTrip(req)
 	}
 
 	// Clone the request to avoid modifying the original request.
 	newReq := req.Clone(req.Context())
-	newReq.Header.Set("Authorization", "Bearer "+token)
+	if creds.Authorization != "" {
+		newReq.Header.Set("Authorization", creds.Authorization)
+	}
+	if creds.Cookie != "" {
+		newReq.Header.Set("Cookie", creds.Cookie)
+	}
 
 	return t.transport().RoundTrip(newReq)
 }
@@ -505,10 +505,26 @@ type MergePullRequestOptions struct {
 // Parameters:
 //   - input: MergePullRequestInput containing the parameters for the request
 //
+// In dry-run mode the merge status is checked first, so that a pull request
+// that can't be merged fails the dry run.
+//
 // Returns:
 //   - types.MapOutput: The merge result data retrieved from the API
 //   - error: An error if the request fails
 func (c *BitbucketClient) MergePullRequest(ctx context.Context, input MergePullRequestInput) (types.MapOutput, error) {
+	if client.IsDryRun(ctx) {
+		status, err := c.TestPullRequestCanMerge(ctx, TestPullRequestCanMergeInput{
+			CommonInput:   input.CommonInput,
+			PullRequestID: input.PullRequestID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := mergeStatusError(status); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create options struct from input fields that are merge options
 	options := MergePullRequestOptions{
 		AutoMerge:   input.AutoMerge,
@@ -544,6 +560,32 @@ func (c *BitbucketClient) MergePullRequest(ctx context.Context, input MergePullR
 	return output, nil
 }
 
+// mergeStatusError returns an error describing why a pull request can't be merged,
+// or nil if the merge status allows the merge
+func mergeStatusError(status types.MapOutput) error {
+	if canMerge, _ := status["canMerge"].(bool); canMerge {
+		return nil
+	}
+
+	var reasons []string
+	if conflicted, _ := status["conflicted"].(bool); conflicted {
+		reasons = append(reasons, "the pull request has conflicts")
+	}
+	if vetoes, ok := status["vetoes"].([]any); ok {
+		for _, veto := range vetoes {
+			if v, ok := veto.(map[string]any); ok {
+				if msg, ok := v["summaryMessage"].(string); ok && msg != "" {
+					reasons = append(reasons, msg)
+				}
+			}
+		}
+	}
+	if len(reasons) == 0 {
+		return fmt.Errorf("pull request cannot be merged")
+	}
+	return fmt.Errorf("pull request cannot be merged: %s", strings.Join(reasons, "; "))
+}
+
 // DeclinePullRequestOptions represents the options for declining a pull request.
 type DeclinePullRequestOptions struct {
 	Comment *string `json:"comment,omitempty"`
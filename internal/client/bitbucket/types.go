@@ -1,5 +1,7 @@
 package bitbucket
 
+import "atlassian-dc-mcp-go/internal/client"
+
 // CommonInput represents common input parameters for Bitbucket operations
 type CommonInput struct {
 	ProjectKey string `json:"projectKey" jsonschema:"required,The project key"`
@@ -8,6 +10,28 @@ type CommonInput struct {
 
 // PaginationInput represents pagination parameters
 type PaginationInput struct {
-	Start int `json:"start,omitempty" jsonschema:"Start number for the page (inclusive). If not passed, first page is assumed"`
-	Limit int `json:"limit,omitempty" jsonschema:"Number of items to return. If not passed, a page size of 25 is used"`
+	Start    int  `json:"start,omitempty" jsonschema:"Start number for the page (inclusive). If not passed, first page is assumed"`
+	Limit    int  `json:"limit,omitempty" jsonschema:"Number of items to return. If not passed, a page size of 25 is used"`
+	FetchAll bool `json:"fetchAll,omitempty" jsonschema:"Whether to fetch all pages and return their items merged, up to maxItems"`
+	MaxItems int  `json:"maxItems,omitempty" jsonschema:"The maximum number of items to return when fetchAll is set (default: 500)"`
+}
+
+// PagingStyle returns how Bitbucket pages its results
+func (p PaginationInput) PagingStyle() client.PagingStyle {
+	return client.PagingNextStart
+}
+
+// FetchAllPages returns whether all pages are requested and the maximum number of items
+func (p PaginationInput) FetchAllPages() (bool, int) {
+	return p.FetchAll, p.MaxItems
+}
+
+// StartPage returns the first page requested by the input
+func (p PaginationInput) StartPage() client.Page {
+	return client.Page{Start: p.Start}
+}
+
+// SetPage sets the page requested by the input
+func (p *PaginationInput) SetPage(page client.Page) {
+	p.Start = page.Start
 }
@@ -0,0 +1,263 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"atlassian-dc-mcp-go/internal/config"
+)
+
+// cacheEntry is a cached GET response body together with its validators
+type cacheEntry struct {
+	key          string
+	path         string
+	body         []byte
+	etag         string
+	lastModified string
+	expires      time.Time
+}
+
+// revalidatable reports whether the entry can be revalidated with a conditional request
+func (e *cacheEntry) revalidatable() bool {
+	return e.etag != "" || e.lastModified != ""
+}
+
+// ResponseCache is an in-process, size-bounded LRU cache of GET response bodies.
+//
+// Entries are keyed by the identity of the caller's credentials, the method, the
+// path and the query. Expired entries that carry an ETag or Last-Modified header
+// are kept so that they can be revalidated with a conditional request.
+type ResponseCache struct {
+	cfg config.CacheConfig
+
+	mu       sync.Mutex
+	entries  map[string]*list.Element
+	lru      *list.List
+	size     int64
+	maxBytes int64
+}
+
+// NewResponseCache creates a response cache for the configuration, or returns nil
+// when caching is disabled.
+func NewResponseCache(cfg config.CacheConfig) *ResponseCache {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return &ResponseCache{
+		cfg:      cfg,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+		maxBytes: int64(cfg.MaxSizeMB) * 1024 * 1024,
+	}
+}
+
+// ttl returns the time to live for responses of the resource path.
+// The first matching endpoint wins; other paths use the default TTL.
+func (c *ResponseCache) ttl(resourcePath string) time.Duration {
+	for _, endpoint := range c.cfg.Endpoints {
+		if ok, _ := path.Match(strings.Trim(endpoint.Path, "/"), resourcePath); ok {
+			return time.Duration(endpoint.TTL) * time.Second
+		}
+	}
+	return time.Duration(c.cfg.TTL) * time.Second
+}
+
+// get returns a copy of the entry for the key, dropping it if it expired and cannot be revalidated
+func (c *ResponseCache) get(key string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	entry := elem.Value.(*cacheEntry)
+	if time.Now().After(entry.expires) && !entry.revalidatable() {
+		c.remove(elem)
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	snapshot := *entry
+	return &snapshot, true
+}
+
+// put stores a response body, evicting the least recently used entries if needed
+func (c *ResponseCache) put(key, resourcePath string, body []byte, header http.Header, ttl time.Duration) {
+	if int64(len(body)) > c.maxBytes {
+		return
+	}
+
+	entry := &cacheEntry{
+		key:          key,
+		path:         resourcePath,
+		body:         body,
+		etag:         header.Get("ETag"),
+		lastModified: header.Get("Last-Modified"),
+		expires:      time.Now().Add(ttl),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+	c.size += int64(len(body))
+
+	for c.size > c.maxBytes || (c.cfg.MaxEntries > 0 && c.lru.Len() > c.cfg.MaxEntries) {
+		c.remove(c.lru.Back())
+	}
+}
+
+// refresh extends the lifetime of an entry after a successful revalidation
+func (c *ResponseCache) refresh(key string, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*cacheEntry).expires = time.Now().Add(ttl)
+	}
+}
+
+// Invalidate removes all entries whose path overlaps the written resource path,
+// i.e. the resource itself, its parents and its sub-resources, for every identity.
+func (c *ResponseCache) Invalidate(resourcePath string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, elem := range c.entries {
+		entry := elem.Value.(*cacheEntry)
+		if pathsOverlap(entry.path, resourcePath) {
+			c.remove(elem)
+		}
+	}
+}
+
+// remove deletes an entry. The caller must hold c.mu.
+func (c *ResponseCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= int64(len(entry.body))
+}
+
+// pathsOverlap reports whether one path equals the other or is one of its ancestors
+func pathsOverlap(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+// cacheKey builds the cache key of a request for the given credentials
+func cacheKey(creds Credentials, req *http.Request) string {
+	return credentialHash(creds) + " " + req.Method + " " + req.URL.Path + "?" + req.URL.RawQuery
+}
+
+// resourcePath returns the path of the request relative to the service base URL
+func resourcePath(baseURL string, req *http.Request) string {
+	basePath := ""
+	if u, err := url.Parse(baseURL); err == nil {
+		basePath = strings.TrimSuffix(u.Path, "/")
+	}
+	return strings.Trim(strings.TrimPrefix(req.URL.Path, basePath), "/")
+}
+
+// cachedRequest tracks the cache state of a single cacheable GET request
+type cachedRequest struct {
+	cache *ResponseCache
+	key   string
+	path  string
+	ttl   time.Duration
+	entry *cacheEntry
+	// revalidate disables serving the entry without asking the server
+	revalidate bool
+}
+
+// revalidateKey is the context key that forces the revalidation of cached responses
+type revalidateKey struct{}
+
+// WithRevalidation returns a context whose GET requests are always sent to the
+// service, for reads that must see the latest state such as version checks.
+// Cached responses are still used for conditional requests.
+func WithRevalidation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, revalidateKey{}, true)
+}
+
+// cacheRequest returns the cache state of the request, or nil if the request is not cacheable
+func (c *BaseClient) cacheRequest(ctx context.Context, req *http.Request) *cachedRequest {
+	if c.Cache == nil || req.Method != http.MethodGet {
+		return nil
+	}
+
+	resPath := resourcePath(c.Config.URL, req)
+	ttl := c.Cache.ttl(resPath)
+	if ttl <= 0 {
+		return nil
+	}
+
+	creds, _ := ctx.Value(c.TokenKey).(Credentials)
+	cached := &cachedRequest{
+		cache: c.Cache,
+		key:   cacheKey(creds, req),
+		path:  resPath,
+		ttl:   ttl,
+	}
+	cached.revalidate, _ = ctx.Value(revalidateKey{}).(bool)
+	cached.entry, _ = c.Cache.get(cached.key)
+	return cached
+}
+
+// invalidateCache drops cached responses that overlap the resource written by the request
+func (c *BaseClient) invalidateCache(req *http.Request) {
+	if c.Cache == nil || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return
+	}
+	c.Cache.Invalidate(resourcePath(c.Config.URL, req))
+}
+
+// fresh returns the cached body if it has not expired yet
+func (r *cachedRequest) fresh() ([]byte, bool) {
+	if r.entry == nil || r.revalidate || time.Now().After(r.entry.expires) {
+		return nil, false
+	}
+	return r.entry.body, true
+}
+
+// addValidators turns the request into a conditional request for an expired entry
+func (r *cachedRequest) addValidators(req *http.Request) {
+	if r.entry == nil {
+		return
+	}
+	if r.entry.etag != "" {
+		req.Header.Set("If-None-Match", r.entry.etag)
+	}
+	if r.entry.lastModified != "" {
+		req.Header.Set("If-Modified-Since", r.entry.lastModified)
+	}
+}
+
+// notModified handles a 304 response and returns the revalidated body,
+// or false if there was no entry to revalidate
+func (r *cachedRequest) notModified() ([]byte, bool) {
+	if r.entry == nil {
+		return nil, false
+	}
+	r.cache.refresh(r.key, r.ttl)
+	return r.entry.body, true
+}
+
+// store caches a successful response unless the server forbids it
+func (r *cachedRequest) store(body []byte, header http.Header) {
+	if strings.Contains(header.Get("Cache-Control"), "no-store") {
+		return
+	}
+	r.cache.put(r.key, r.path, body, header, r.ttl)
+}
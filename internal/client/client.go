@@ -1,9 +1,11 @@
 package client
 
 import (
+	"net/http"
 	"time"
 
 	"atlassian-dc-mcp-go/internal/config"
+	"atlassian-dc-mcp-go/internal/metrics"
 
 	"github.com/hashicorp/go-retryablehttp"
 )
@@ -12,6 +14,12 @@ type BaseClient struct {
 	Config     *config.ClientConfig
 	HTTPClient *retryablehttp.Client
 	Name       string
+	// TokenKey is the context key of the credentials used for requests
+	TokenKey ContextKey
+	// Cache holds GET responses; nil when caching is disabled
+	Cache *ResponseCache
+	// Limiter paces the requests sent to the service
+	Limiter *RateLimiter
 }
 
 // NewBaseClient creates a new BaseClient with the provided configuration and name.
@@ -30,16 +38,34 @@ func NewBaseClient(config *config.ClientConfig, name string, tokenKey ContextKey
 		TokenKey: tokenKey,
 	})
 
+	// Count retries; attempt 0 is the initial request
+	httpClient.RequestLogHook = func(_ retryablehttp.Logger, _ *http.Request, attempt int) {
+		if attempt > 0 {
+			metrics.ObserveRetry(name)
+		}
+	}
+
+	// Rate limit and trace every attempt, including retries
+	limiter := NewRateLimiter(config.RateLimit)
+	httpClient.HTTPClient.Transport = &rateLimitTransport{
+		limiter: limiter,
+		transport: &tracingTransport{
+			service:   name,
+			transport: httpClient.HTTPClient.Transport,
+		},
+	}
+
 	return &BaseClient{
 		Config:     config,
 		HTTPClient: httpClient,
 		Name:       name,
+		TokenKey:   tokenKey,
+		Cache:      NewResponseCache(config.Cache),
+		Limiter:    limiter,
 	}, nil
 }
 
 // For backward compatibility, initialize with default values
 func init() {
-	defaultCfg := config.DefaultPruneConfig()
-	fuzzyKeys = defaultCfg.FuzzyKeys
-	removePaths = defaultCfg.RemovePaths
+	InitPruneConfig(config.DefaultPruneConfig())
 }
@@ -4,7 +4,9 @@ package confluence
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
 	"net/url"
 
@@ -60,6 +62,10 @@ func (c *ConfluenceClient) GetContent(ctx context.Context, input GetContentInput
 //   - types.MapOutput: The content data
 //   - error: An error if the request fails
 func (c *ConfluenceClient) GetContentByID(ctx context.Context, input GetContentByIDInput) (types.MapOutput, error) {
+	if err := validateRepresentation(input.Representation); err != nil {
+		return nil, err
+	}
+
 	queryParams := url.Values{}
 	if len(input.Expand) == 0 {
 		input.Expand = []string{"container", "body.storage", "metadata.labels", "space", "version"}
@@ -80,6 +86,12 @@ func (c *ConfluenceClient) GetContentByID(ctx context.Context, input GetContentB
 		return nil, err
 	}
 
+	if input.Representation == RepresentationMarkdown {
+		if err := storageBodyToMarkdown(output); err != nil {
+			return nil, err
+		}
+	}
+
 	return output, nil
 }
 
@@ -125,6 +137,17 @@ func (c *ConfluenceClient) SearchContent(ctx context.Context, input SearchConten
 //   - types.MapOutput: The created content data
 //   - error: An error if the request fails
 func (c *ConfluenceClient) CreateContent(ctx context.Context, input CreateContentInput) (types.MapOutput, error) {
+	if err := validateRepresentation(input.Representation); err != nil {
+		return nil, err
+	}
+	if input.Representation == RepresentationMarkdown {
+		body, err := markdownBody(input.Body)
+		if err != nil {
+			return nil, err
+		}
+		input.Body = body
+	}
+
 	payload := types.MapOutput{}
 	client.SetRequestBodyParam(payload, "type", input.Type)
 	client.SetRequestBodyParam(payload, "title", input.Title)
@@ -164,6 +187,22 @@ func (c *ConfluenceClient) CreateContent(ctx context.Context, input CreateConten
 //   - types.MapOutput: The updated content data
 //   - error: An error if the request fails
 func (c *ConfluenceClient) UpdateContent(ctx context.Context, input UpdateContentInput) (types.MapOutput, error) {
+	if err := validateRepresentation(input.Representation); err != nil {
+		return nil, err
+	}
+	if input.Representation == RepresentationMarkdown {
+		body, ok := input.ContentData["body"].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("contentData.body is required for the markdown representation")
+		}
+		converted, err := markdownBody(body)
+		if err != nil {
+			return nil, err
+		}
+		input.ContentData = maps.Clone(input.ContentData)
+		input.ContentData["body"] = converted
+	}
+
 	jsonPayload, err := json.Marshal(input.ContentData)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal payload: %w", err)
@@ -186,6 +225,142 @@ func (c *ConfluenceClient) UpdateContent(ctx context.Context, input UpdateConten
 	return output, nil
 }
 
+// UpdatePage updates the title and body of a page based on its current version.
+//
+// The current page is fetched to carry over its type, title, status, space and
+// parent, and its version number is incremented. If the page has been edited since
+// the expected version, or while the update was sent, a CONFLICT error is returned.
+//
+// Parameters:
+//   - input: UpdatePageInput containing the parameters for the request
+//
+// Returns:
+//   - types.MapOutput: The updated page data
+//   - error: An error if the request fails or the page has been edited concurrently
+func (c *ConfluenceClient) UpdatePage(ctx context.Context, input UpdatePageInput) (types.MapOutput, error) {
+	if err := validateRepresentation(input.Representation); err != nil {
+		return nil, err
+	}
+
+	current, err := c.GetContentByID(client.WithRevalidation(ctx), GetContentByIDInput{
+		ContentID: input.PageID,
+		Expand:    []string{"space", "version", "ancestors", "body.storage"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	version, ok := nestedValue(current, "version", "number").(float64)
+	if !ok {
+		return nil, fmt.Errorf("page %s has no version number", input.PageID)
+	}
+	if input.ExpectedVersion != 0 && input.ExpectedVersion != int(version) {
+		return nil, versionConflict(input.PageID, input.ExpectedVersion, current)
+	}
+
+	title := input.Title
+	if title == "" {
+		title, _ = current["title"].(string)
+	}
+
+	body := input.Body
+	switch {
+	case body == "":
+		body, _ = nestedValue(current, "body", "storage", "value").(string)
+	case input.Representation == RepresentationMarkdown:
+		body = MarkdownToStorage(body)
+	}
+
+	versionData := types.MapOutput{"number": int(version) + 1, "minorEdit": input.MinorEdit}
+	client.SetRequestBodyParam(versionData, "message", input.Message)
+
+	payload := types.MapOutput{
+		"id":    input.PageID,
+		"type":  current["type"],
+		"title": title,
+		"body": types.MapOutput{
+			"storage": types.MapOutput{"value": body, "representation": RepresentationStorage},
+		},
+		"version": versionData,
+	}
+	if status, ok := current["status"].(string); ok {
+		payload["status"] = status
+	}
+	if spaceKey, ok := nestedValue(current, "space", "key").(string); ok {
+		payload["space"] = types.MapOutput{"key": spaceKey}
+	}
+	// The last ancestor is the parent page
+	if ancestors, ok := current["ancestors"].([]any); ok && len(ancestors) > 0 {
+		if parent, ok := ancestors[len(ancestors)-1].(map[string]any); ok {
+			payload["ancestors"] = []types.MapOutput{{"id": parent["id"]}}
+		}
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	var output types.MapOutput
+	if err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPut,
+		[]any{"rest", "api", "content", input.PageID},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		&output,
+	); err != nil {
+		var apiErr *types.Error
+		if errors.As(err, &apiErr) && apiErr.Code == "CONFLICT" {
+			return nil, &types.Error{
+				Code:    "CONFLICT",
+				Message: fmt.Sprintf("page %s was edited while version %d was being updated, fetch the page and retry: %s", input.PageID, int(version), apiErr.Message),
+				Details: types.MapOutput{"pageId": input.PageID, "baseVersion": int(version)},
+			}
+		}
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// versionConflict describes the edit that happened since the expected version of a page
+func versionConflict(pageID string, expected int, current types.MapOutput) error {
+	details := types.MapOutput{
+		"pageId":          pageID,
+		"expectedVersion": expected,
+		"currentVersion":  nestedValue(current, "version", "number"),
+	}
+	message := fmt.Sprintf("page %s has been edited since version %d, it is now at version %v", pageID, expected, details["currentVersion"])
+
+	if when, ok := nestedValue(current, "version", "when").(string); ok {
+		details["when"] = when
+		message += " (" + when
+		if by, ok := nestedValue(current, "version", "by", "username").(string); ok {
+			details["by"] = by
+			message += " by " + by
+		}
+		message += ")"
+	}
+
+	return &types.Error{Code: "CONFLICT", Message: message, Details: details}
+}
+
+// nestedValue returns the value at a path of keys in decoded JSON, or nil if it doesn't exist
+func nestedValue(data map[string]any, keys ...string) any {
+	var value any = data
+	for _, key := range keys {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
 // DeleteContent deletes content by its ID.
 //
 // Parameters:
@@ -248,6 +423,13 @@ func (c *ConfluenceClient) GetContentHistory(ctx context.Context, input GetConte
 //   - types.MapOutput: The added comment data
 //   - error: An error if the request fails
 func (c *ConfluenceClient) AddComment(ctx context.Context, input AddCommentInput) (types.MapOutput, error) {
+	if err := validateRepresentation(input.Representation); err != nil {
+		return nil, err
+	}
+	if input.Representation == RepresentationMarkdown {
+		input.CommentBody = MarkdownToStorage(input.CommentBody)
+	}
+
 	payload := types.MapOutput{
 		"type": "comment",
 		"container": map[string]string{
@@ -1,6 +1,9 @@
 package confluence
 
-import "atlassian-dc-mcp-go/internal/types"
+import (
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+)
 
 // GetContentInput represents the input parameters for getting content
 type GetContentInput struct {
@@ -15,24 +18,38 @@ type GetContentInput struct {
 
 // GetContentByIDInput represents the input parameters for getting content by ID
 type GetContentByIDInput struct {
-	ContentID string   `json:"contentID" jsonschema:"required,The ID of the content to retrieve"`
-	Expand    []string `json:"expand,omitempty" jsonschema:"Fields to expand in the response"`
+	ContentID      string   `json:"contentID" jsonschema:"required,The ID of the content to retrieve"`
+	Expand         []string `json:"expand,omitempty" jsonschema:"Fields to expand in the response"`
+	Representation string   `json:"representation,omitempty" jsonschema:"The representation of the returned body: storage (default) or markdown"`
 }
 
 // CreateContentInput represents the input parameters for creating content
 type CreateContentInput struct {
-	Type      string            `json:"type" jsonschema:"required,The type of the content"`
-	Title     string            `json:"title" jsonschema:"required,The title of the content"`
-	Space     types.MapOutput   `json:"space" jsonschema:"required,The space information"`
-	Body      types.MapOutput   `json:"body" jsonschema:"required,The body of the content"`
-	Ancestors []types.MapOutput `json:"ancestors,omitempty" jsonschema:"The ancestor information"`
-	Metadata  types.MapOutput   `json:"metadata,omitempty" jsonschema:"The metadata information"`
+	Type           string            `json:"type" jsonschema:"required,The type of the content"`
+	Title          string            `json:"title" jsonschema:"required,The title of the content"`
+	Space          types.MapOutput   `json:"space" jsonschema:"required,The space information"`
+	Body           types.MapOutput   `json:"body" jsonschema:"required,The body of the content"`
+	Ancestors      []types.MapOutput `json:"ancestors,omitempty" jsonschema:"The ancestor information"`
+	Metadata       types.MapOutput   `json:"metadata,omitempty" jsonschema:"The metadata information"`
+	Representation string            `json:"representation,omitempty" jsonschema:"The representation of body.storage.value: storage (default) or markdown"`
 }
 
 // UpdateContentInput represents the input parameters for updating content
 type UpdateContentInput struct {
-	ContentID   string          `json:"contentID" jsonschema:"required,The ID of the content to update"`
-	ContentData types.MapOutput `json:"contentData" jsonschema:"required,The updated content data"`
+	ContentID      string          `json:"contentID" jsonschema:"required,The ID of the content to update"`
+	ContentData    types.MapOutput `json:"contentData" jsonschema:"required,The updated content data"`
+	Representation string          `json:"representation,omitempty" jsonschema:"The representation of contentData.body.storage.value: storage (default) or markdown"`
+}
+
+// UpdatePageInput represents the input parameters for UpdatePage method.
+type UpdatePageInput struct {
+	PageID          string `json:"pageId" jsonschema:"required,The ID of the page to update"`
+	Title           string `json:"title,omitempty" jsonschema:"The new title of the page, defaults to the current title"`
+	Body            string `json:"body,omitempty" jsonschema:"The new body of the page, defaults to the current body"`
+	Representation  string `json:"representation,omitempty" jsonschema:"The representation of the body: storage (default) or markdown"`
+	ExpectedVersion int    `json:"expectedVersion,omitempty" jsonschema:"The version number the update is based on. The update fails with a conflict if the page has been edited since"`
+	Message         string `json:"message,omitempty" jsonschema:"The version comment"`
+	MinorEdit       bool   `json:"minorEdit,omitempty" jsonschema:"Whether the update is a minor edit that doesn't notify watchers"`
 }
 
 // DeleteContentInput represents the input parameters for deleting content
@@ -69,8 +86,9 @@ type GetCommentsInput struct {
 
 // AddCommentInput represents the input parameters for AddComment method.
 type AddCommentInput struct {
-	ContentID   string `json:"contentId" jsonschema:"required,The ID of the content to add comment to"`
-	CommentBody string `json:"commentBody" jsonschema:"required,The body of the comment"`
+	ContentID      string `json:"contentId" jsonschema:"required,The ID of the content to add comment to"`
+	CommentBody    string `json:"commentBody" jsonschema:"required,The body of the comment"`
+	Representation string `json:"representation,omitempty" jsonschema:"The representation of the comment body: storage (default) or markdown"`
 }
 
 // GetAttachmentsInput represents the input parameters for GetAttachments method.
@@ -98,3 +116,19 @@ type ScanContentBySpaceKeyInput struct {
 	Expand     []string `json:"expand,omitempty" jsonschema:"Fields to expand in the response"`
 	Cursor     string   `json:"cursor,omitempty" jsonschema:"The cursor for pagination"`
 }
+
+// StartPage returns the first page requested by the input
+func (i ScanContentBySpaceKeyInput) StartPage() client.Page {
+	return client.Page{Start: i.Start, Cursor: i.Cursor}
+}
+
+// SetPage sets the page requested by the input. The scan pages with a cursor
+// instead of a start index once the first page has been fetched.
+func (i *ScanContentBySpaceKeyInput) SetPage(page client.Page) {
+	i.Cursor = page.Cursor
+	if page.Cursor != "" {
+		i.Start = 0
+	} else {
+		i.Start = page.Start
+	}
+}
@@ -0,0 +1,750 @@
+package confluence
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"atlassian-dc-mcp-go/internal/markdown"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// Body representations accepted and returned by the content tools
+const (
+	RepresentationStorage  = "storage"
+	RepresentationMarkdown = "markdown"
+)
+
+// codeLanguages maps common Markdown code block languages to the names used by
+// the Confluence code macro
+var codeLanguages = map[string]string{
+	"javascript": "js",
+	"python":     "py",
+	"yaml":       "yml",
+	"sh":         "bash",
+	"shell":      "bash",
+	"zsh":        "bash",
+	"csharp":     "c#",
+	"cs":         "c#",
+	"c++":        "cpp",
+	"html":       "xml",
+	"golang":     "go",
+}
+
+// pageLinkPattern matches a page link destination that starts with a space key
+var pageLinkPattern = regexp.MustCompile(`^([A-Za-z0-9~_]+):(.+)$`)
+
+// whitespacePattern matches the whitespace that XHTML collapses into a single space
+var whitespacePattern = regexp.MustCompile(`[ \t\r\n]+`)
+
+// validateRepresentation checks that a body representation is supported
+func validateRepresentation(representation string) error {
+	switch representation {
+	case "", RepresentationStorage, RepresentationMarkdown:
+		return nil
+	default:
+		return fmt.Errorf("unsupported representation %q, expected %q or %q", representation, RepresentationStorage, RepresentationMarkdown)
+	}
+}
+
+// markdownBody converts the Markdown value of a content body to the storage format.
+// The Markdown is read from body.markdown.value or body.storage.value.
+func markdownBody(body types.MapOutput) (types.MapOutput, error) {
+	for _, key := range []string{RepresentationMarkdown, RepresentationStorage} {
+		representation, ok := body[key].(map[string]any)
+		if !ok {
+			continue
+		}
+		value, ok := representation["value"].(string)
+		if !ok {
+			continue
+		}
+
+		converted := types.MapOutput{}
+		for k, v := range body {
+			if k != RepresentationMarkdown {
+				converted[k] = v
+			}
+		}
+		converted[RepresentationStorage] = types.MapOutput{
+			"value":          MarkdownToStorage(value),
+			"representation": RepresentationStorage,
+		}
+		return converted, nil
+	}
+	return nil, fmt.Errorf("body.storage.value is required for the markdown representation")
+}
+
+// storageBodyToMarkdown replaces the storage representation of a content body
+// with its Markdown conversion
+func storageBodyToMarkdown(content types.MapOutput) error {
+	body, ok := content["body"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	storage, ok := body[RepresentationStorage].(map[string]any)
+	if !ok {
+		return nil
+	}
+	value, ok := storage["value"].(string)
+	if !ok {
+		return nil
+	}
+
+	md, err := StorageToMarkdown(value)
+	if err != nil {
+		return err
+	}
+
+	delete(body, RepresentationStorage)
+	body[RepresentationMarkdown] = types.MapOutput{
+		"value":          md,
+		"representation": RepresentationMarkdown,
+	}
+	return nil
+}
+
+// MarkdownToStorage converts Markdown to the Confluence storage format.
+// Code blocks become code macros, task lists become Confluence tasks and images
+// become ac:image elements referencing a URL or, for plain file names, an attachment
+// of the page.
+func MarkdownToStorage(md string) string {
+	var b strings.Builder
+	writeStorageBlocks(&b, markdown.Parse(md).Children, false)
+	return b.String()
+}
+
+// writeStorageBlocks writes block nodes. In tight lists paragraphs are written
+// without <p> elements.
+func writeStorageBlocks(b *strings.Builder, blocks []*markdown.Node, tight bool) {
+	for _, n := range blocks {
+		if tight && n.Kind == markdown.Paragraph {
+			writeStorageInlines(b, n.Children)
+			continue
+		}
+		writeStorageBlock(b, n)
+	}
+}
+
+func writeStorageBlock(b *strings.Builder, n *markdown.Node) {
+	switch n.Kind {
+	case markdown.Paragraph:
+		b.WriteString("<p>")
+		writeStorageInlines(b, n.Children)
+		b.WriteString("</p>")
+	case markdown.Heading:
+		fmt.Fprintf(b, "<h%d>", n.Level)
+		writeStorageInlines(b, n.Children)
+		fmt.Fprintf(b, "</h%d>", n.Level)
+	case markdown.CodeBlock:
+		b.WriteString(`<ac:structured-macro ac:name="code">`)
+		if n.Language != "" {
+			language := strings.ToLower(n.Language)
+			if alias, ok := codeLanguages[language]; ok {
+				language = alias
+			}
+			b.WriteString(`<ac:parameter ac:name="language">` + html.EscapeString(language) + `</ac:parameter>`)
+		}
+		// "]]>" can't appear in a CDATA section, so it is split across two sections
+		code := strings.ReplaceAll(n.Text, "]]>", "]]]]><![CDATA[>")
+		b.WriteString("<ac:plain-text-body><![CDATA[" + code + "]]></ac:plain-text-body></ac:structured-macro>")
+	case markdown.BlockQuote:
+		b.WriteString("<blockquote>")
+		writeStorageBlocks(b, n.Children, false)
+		b.WriteString("</blockquote>")
+	case markdown.List:
+		writeStorageList(b, n)
+	case markdown.Table:
+		writeStorageTable(b, n)
+	case markdown.ThematicBreak:
+		b.WriteString("<hr/>")
+	}
+}
+
+// writeStorageList writes a list, or a Confluence task list if all items are tasks
+func writeStorageList(b *strings.Builder, n *markdown.Node) {
+	tasks := len(n.Children) > 0
+	for _, item := range n.Children {
+		tasks = tasks && item.Task
+	}
+
+	if tasks {
+		b.WriteString("<ac:task-list>")
+		for _, item := range n.Children {
+			status := "incomplete"
+			if item.Checked {
+				status = "complete"
+			}
+			b.WriteString("<ac:task><ac:task-status>" + status + "</ac:task-status><ac:task-body>")
+			writeStorageBlocks(b, item.Children, true)
+			b.WriteString("</ac:task-body></ac:task>")
+		}
+		b.WriteString("</ac:task-list>")
+		return
+	}
+
+	tag := "ul"
+	if n.Ordered {
+		tag = "ol"
+	}
+	b.WriteString("<" + tag + ">")
+	for _, item := range n.Children {
+		b.WriteString("<li>")
+		if item.Task {
+			if item.Checked {
+				b.WriteString("[x] ")
+			} else {
+				b.WriteString("[ ] ")
+			}
+		}
+		writeStorageBlocks(b, item.Children, n.Tight)
+		b.WriteString("</li>")
+	}
+	b.WriteString("</" + tag + ">")
+}
+
+func writeStorageTable(b *strings.Builder, n *markdown.Node) {
+	b.WriteString("<table><tbody>")
+	for _, row := range n.Children {
+		b.WriteString("<tr>")
+		for c, cell := range row.Children {
+			tag := "td"
+			if cell.Header {
+				tag = "th"
+			}
+
+			style := ""
+			if c < len(n.Align) {
+				switch n.Align[c] {
+				case markdown.AlignCenter:
+					style = ` style="text-align: center;"`
+				case markdown.AlignRight:
+					style = ` style="text-align: right;"`
+				}
+			}
+
+			b.WriteString("<" + tag + style + ">")
+			writeStorageInlines(b, cell.Children)
+			b.WriteString("</" + tag + ">")
+		}
+		b.WriteString("</tr>")
+	}
+	b.WriteString("</tbody></table>")
+}
+
+func writeStorageInlines(b *strings.Builder, nodes []*markdown.Node) {
+	for _, n := range nodes {
+		switch n.Kind {
+		case markdown.Text:
+			b.WriteString(html.EscapeString(n.Text))
+		case markdown.Emphasis:
+			b.WriteString("<em>")
+			writeStorageInlines(b, n.Children)
+			b.WriteString("</em>")
+		case markdown.Strong:
+			b.WriteString("<strong>")
+			writeStorageInlines(b, n.Children)
+			b.WriteString("</strong>")
+		case markdown.Strikethrough:
+			b.WriteString(`<span style="text-decoration: line-through;">`)
+			writeStorageInlines(b, n.Children)
+			b.WriteString("</span>")
+		case markdown.Code:
+			b.WriteString("<code>" + html.EscapeString(n.Text) + "</code>")
+		case markdown.Link:
+			writeStorageLink(b, n)
+		case markdown.Image:
+			writeStorageImage(b, n)
+		case markdown.LineBreak:
+			b.WriteString("<br/>")
+		}
+	}
+}
+
+// writeStorageLink writes a link. Destinations that aren't URLs, such as
+// "Page title" or "SPACE:Page title#anchor", link to Confluence pages.
+func writeStorageLink(b *strings.Builder, n *markdown.Node) {
+	if isURL(n.URL) {
+		b.WriteString(`<a href="` + html.EscapeString(n.URL) + `">`)
+		writeStorageInlines(b, n.Children)
+		b.WriteString("</a>")
+		return
+	}
+
+	target, anchor, _ := strings.Cut(n.URL, "#")
+	b.WriteString("<ac:link")
+	if anchor != "" {
+		b.WriteString(` ac:anchor="` + html.EscapeString(anchor) + `"`)
+	}
+	b.WriteString("><ri:page")
+	if m := pageLinkPattern.FindStringSubmatch(target); m != nil {
+		b.WriteString(` ri:space-key="` + html.EscapeString(m[1]) + `"`)
+		target = m[2]
+	}
+	b.WriteString(` ri:content-title="` + html.EscapeString(target) + `"/><ac:link-body>`)
+	writeStorageInlines(b, n.Children)
+	b.WriteString("</ac:link-body></ac:link>")
+}
+
+// isURL reports whether a link destination is a URL rather than a page title
+func isURL(destination string) bool {
+	return destination == "" || strings.Contains(destination, "://") ||
+		strings.HasPrefix(destination, "/") || strings.HasPrefix(destination, "#") ||
+		strings.HasPrefix(destination, "?") || strings.HasPrefix(destination, "mailto:")
+}
+
+// writeStorageImage writes an image that references a URL, or an attachment of
+// the page if the source is a plain file name
+func writeStorageImage(b *strings.Builder, n *markdown.Node) {
+	b.WriteString("<ac:image")
+	if n.Text != "" {
+		b.WriteString(` ac:alt="` + html.EscapeString(n.Text) + `"`)
+	}
+	b.WriteString(">")
+	if strings.Contains(n.URL, "/") || strings.Contains(n.URL, ":") {
+		b.WriteString(`<ri:url ri:value="` + html.EscapeString(n.URL) + `"/>`)
+	} else {
+		b.WriteString(`<ri:attachment ri:filename="` + html.EscapeString(n.URL) + `"/>`)
+	}
+	b.WriteString("</ac:image>")
+}
+
+// storageNode is an element or, if its name is empty, a text node of a storage format document
+type storageNode struct {
+	name     string
+	attrs    map[string]string
+	children []*storageNode
+	text     string
+}
+
+// child returns the first child element with the given name
+func (n *storageNode) child(name string) *storageNode {
+	for _, c := range n.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// textContent returns the text of the node and its descendants
+func (n *storageNode) textContent() string {
+	if n.name == "" {
+		return n.text
+	}
+	var text strings.Builder
+	for _, c := range n.children {
+		text.WriteString(c.textContent())
+	}
+	return text.String()
+}
+
+// parameters returns the values of the ac:parameter children of a macro
+func (n *storageNode) parameters() map[string]string {
+	params := make(map[string]string)
+	for _, c := range n.children {
+		if c.name == "ac:parameter" {
+			params[c.attrs["ac:name"]] = c.textContent()
+		}
+	}
+	return params
+}
+
+// storageAutoClose lists the void HTML elements that don't need to be closed.
+// "link" is excluded because ac:link shares its local name.
+var storageAutoClose = slices.DeleteFunc(slices.Clone(xml.HTMLAutoClose), func(name string) bool {
+	return name == "link"
+})
+
+// parseStorage parses a storage format document, which is XHTML with elements and
+// attributes in the undeclared ac and ri namespaces and HTML entities
+func parseStorage(storage string) (*storageNode, error) {
+	decoder := xml.NewDecoder(strings.NewReader("<root>" + storage + "</root>"))
+	decoder.Strict = false
+	decoder.AutoClose = storageAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	document := &storageNode{}
+	stack := []*storageNode{document}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse storage format: %w", err)
+		}
+
+		parent := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &storageNode{name: storageName(t.Name), attrs: make(map[string]string)}
+			for _, attr := range t.Attr {
+				node.attrs[storageName(attr.Name)] = attr.Value
+			}
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case xml.CharData:
+			parent.children = append(parent.children, &storageNode{text: string(t)})
+		}
+	}
+	if len(document.children) == 0 {
+		return document, nil
+	}
+	return document.children[0], nil
+}
+
+func storageName(name xml.Name) string {
+	if name.Space != "" {
+		return name.Space + ":" + name.Local
+	}
+	return strings.ToLower(name.Local)
+}
+
+// StorageToMarkdown converts the Confluence storage format to Markdown. Macros
+// without a Markdown equivalent are reduced to their body.
+func StorageToMarkdown(storage string) (string, error) {
+	root, err := parseStorage(storage)
+	if err != nil {
+		return "", err
+	}
+	return markdown.Render(&markdown.Node{Kind: markdown.Document, Children: storageBlocks(root.children)}), nil
+}
+
+// storageBlockElements are the elements converted to Markdown blocks
+var storageBlockElements = map[string]bool{
+	"p": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "table": true, "pre": true, "blockquote": true, "hr": true, "div": true,
+	"ac:task-list": true, "ac:layout": true, "ac:layout-section": true, "ac:layout-cell": true,
+	"ac:rich-text-body": true,
+}
+
+// storageBlockMacros are the macros converted to Markdown blocks
+var storageBlockMacros = map[string]bool{
+	"code": true, "noformat": true, "info": true, "note": true, "tip": true, "warning": true,
+	"panel": true, "expand": true,
+}
+
+func isStorageBlock(n *storageNode) bool {
+	if n.name == "ac:structured-macro" {
+		return storageBlockMacros[n.attrs["ac:name"]]
+	}
+	return storageBlockElements[n.name]
+}
+
+// storageBlocks converts nodes to Markdown blocks, collecting consecutive inline
+// content into paragraphs
+func storageBlocks(nodes []*storageNode) []*markdown.Node {
+	var blocks, inlines []*markdown.Node
+	flush := func() {
+		if inlines = trimInlines(inlines); len(inlines) > 0 {
+			blocks = append(blocks, &markdown.Node{Kind: markdown.Paragraph, Children: inlines})
+		}
+		inlines = nil
+	}
+
+	for _, n := range nodes {
+		if isStorageBlock(n) {
+			flush()
+			blocks = append(blocks, storageBlock(n)...)
+			continue
+		}
+		inlines = append(inlines, storageInlines(n)...)
+	}
+	flush()
+	return blocks
+}
+
+// storageBlock converts a block element
+func storageBlock(n *storageNode) []*markdown.Node {
+	switch n.name {
+	case "p":
+		if inlines := trimInlines(storageInlineChildren(n)); len(inlines) > 0 {
+			return []*markdown.Node{{Kind: markdown.Paragraph, Children: inlines}}
+		}
+		return nil
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		return []*markdown.Node{{Kind: markdown.Heading, Level: int(n.name[1] - '0'), Children: trimInlines(storageInlineChildren(n))}}
+	case "ul", "ol":
+		list := &markdown.Node{Kind: markdown.List, Ordered: n.name == "ol", Tight: true}
+		for _, c := range n.children {
+			if c.name == "li" {
+				list.Children = append(list.Children, &markdown.Node{Kind: markdown.ListItem, Children: storageBlocks(c.children)})
+			}
+		}
+		return []*markdown.Node{list}
+	case "table":
+		return []*markdown.Node{storageTable(n)}
+	case "pre":
+		return []*markdown.Node{{Kind: markdown.CodeBlock, Text: n.textContent()}}
+	case "blockquote":
+		return []*markdown.Node{{Kind: markdown.BlockQuote, Children: storageBlocks(n.children)}}
+	case "hr":
+		return []*markdown.Node{{Kind: markdown.ThematicBreak}}
+	case "ac:task-list":
+		list := &markdown.Node{Kind: markdown.List, Tight: true}
+		for _, task := range n.children {
+			if task.name != "ac:task" {
+				continue
+			}
+			item := &markdown.Node{Kind: markdown.ListItem, Task: true}
+			if status := task.child("ac:task-status"); status != nil {
+				item.Checked = strings.TrimSpace(status.textContent()) == "complete"
+			}
+			if body := task.child("ac:task-body"); body != nil {
+				item.Children = storageBlocks(body.children)
+			}
+			list.Children = append(list.Children, item)
+		}
+		return []*markdown.Node{list}
+	case "ac:structured-macro":
+		return storageMacro(n)
+	default:
+		return storageBlocks(n.children)
+	}
+}
+
+// storageMacro converts a block macro. Panels become block quotes starting with their title.
+func storageMacro(n *storageNode) []*markdown.Node {
+	name := n.attrs["ac:name"]
+	params := n.parameters()
+
+	var body []*markdown.Node
+	if richText := n.child("ac:rich-text-body"); richText != nil {
+		body = storageBlocks(richText.children)
+	}
+
+	switch name {
+	case "code", "noformat":
+		code := &markdown.Node{Kind: markdown.CodeBlock, Language: params["language"]}
+		if plainText := n.child("ac:plain-text-body"); plainText != nil {
+			code.Text = plainText.textContent()
+		}
+		return []*markdown.Node{code}
+	case "info", "note", "tip", "warning", "panel":
+		title := params["title"]
+		if title == "" && name != "panel" {
+			title = strings.ToUpper(name[:1]) + name[1:]
+		}
+		if title != "" {
+			heading := &markdown.Node{Kind: markdown.Paragraph, Children: []*markdown.Node{
+				{Kind: markdown.Strong, Children: []*markdown.Node{{Kind: markdown.Text, Text: title}}},
+			}}
+			body = append([]*markdown.Node{heading}, body...)
+		}
+		return []*markdown.Node{{Kind: markdown.BlockQuote, Children: body}}
+	case "expand":
+		if title := params["title"]; title != "" {
+			heading := &markdown.Node{Kind: markdown.Paragraph, Children: []*markdown.Node{
+				{Kind: markdown.Strong, Children: []*markdown.Node{{Kind: markdown.Text, Text: title}}},
+			}}
+			body = append([]*markdown.Node{heading}, body...)
+		}
+		return body
+	default:
+		return body
+	}
+}
+
+// storageTable converts a table. Cells with a single paragraph are kept inline.
+func storageTable(n *storageNode) *markdown.Node {
+	table := &markdown.Node{Kind: markdown.Table}
+
+	var rows []*storageNode
+	for _, c := range n.children {
+		switch c.name {
+		case "tr":
+			rows = append(rows, c)
+		case "thead", "tbody", "tfoot":
+			for _, r := range c.children {
+				if r.name == "tr" {
+					rows = append(rows, r)
+				}
+			}
+		}
+	}
+
+	for i, r := range rows {
+		row := &markdown.Node{Kind: markdown.TableRow}
+		for _, c := range r.children {
+			if c.name != "th" && c.name != "td" {
+				continue
+			}
+			if i == 0 {
+				table.Align = append(table.Align, storageAlign(c.attrs["style"]))
+			}
+			cell := &markdown.Node{Kind: markdown.TableCell, Header: c.name == "th", Children: storageBlocks(c.children)}
+			if len(cell.Children) == 1 && cell.Children[0].Kind == markdown.Paragraph {
+				cell.Children = cell.Children[0].Children
+			}
+			row.Children = append(row.Children, cell)
+		}
+		table.Children = append(table.Children, row)
+	}
+	return table
+}
+
+// storageAlign returns the column alignment of a cell style
+func storageAlign(style string) markdown.Align {
+	switch {
+	case strings.Contains(style, "text-align: center"):
+		return markdown.AlignCenter
+	case strings.Contains(style, "text-align: right"):
+		return markdown.AlignRight
+	case strings.Contains(style, "text-align: left"):
+		return markdown.AlignLeft
+	default:
+		return markdown.AlignNone
+	}
+}
+
+// storageInlineChildren converts the children of an element to inline nodes
+func storageInlineChildren(n *storageNode) []*markdown.Node {
+	var inlines []*markdown.Node
+	for _, c := range n.children {
+		inlines = append(inlines, storageInlines(c)...)
+	}
+	return inlines
+}
+
+// storageInlines converts a node to inline nodes
+func storageInlines(n *storageNode) []*markdown.Node {
+	switch n.name {
+	case "":
+		return []*markdown.Node{{Kind: markdown.Text, Text: whitespacePattern.ReplaceAllString(n.text, " ")}}
+	case "strong", "b":
+		return []*markdown.Node{{Kind: markdown.Strong, Children: storageInlineChildren(n)}}
+	case "em", "i":
+		return []*markdown.Node{{Kind: markdown.Emphasis, Children: storageInlineChildren(n)}}
+	case "s", "del", "strike":
+		return []*markdown.Node{{Kind: markdown.Strikethrough, Children: storageInlineChildren(n)}}
+	case "span":
+		if strings.Contains(n.attrs["style"], "line-through") {
+			return []*markdown.Node{{Kind: markdown.Strikethrough, Children: storageInlineChildren(n)}}
+		}
+		return storageInlineChildren(n)
+	case "code", "tt":
+		return []*markdown.Node{{Kind: markdown.Code, Text: n.textContent()}}
+	case "a":
+		return []*markdown.Node{{Kind: markdown.Link, URL: n.attrs["href"], Children: storageInlineChildren(n)}}
+	case "br":
+		return []*markdown.Node{{Kind: markdown.LineBreak}}
+	case "time":
+		return []*markdown.Node{{Kind: markdown.Text, Text: n.attrs["datetime"]}}
+	case "ac:image":
+		return []*markdown.Node{{Kind: markdown.Image, Text: n.attrs["ac:alt"], URL: storageResource(n)}}
+	case "ac:link":
+		return storageLink(n)
+	case "ac:emoticon":
+		return []*markdown.Node{{Kind: markdown.Text, Text: n.attrs["ac:emoji-fallback"]}}
+	case "ac:structured-macro":
+		return storageInlineMacro(n)
+	case "ac:placeholder", "ac:parameter":
+		return nil
+	default:
+		// Blocks nested in inline content, such as a paragraph in a link, are flattened
+		return storageInlineChildren(n)
+	}
+}
+
+// storageResource returns the URL or file name of the resource referenced by an element
+func storageResource(n *storageNode) string {
+	for _, c := range n.children {
+		switch c.name {
+		case "ri:url":
+			return c.attrs["ri:value"]
+		case "ri:attachment":
+			return c.attrs["ri:filename"]
+		case "ri:page":
+			if space := c.attrs["ri:space-key"]; space != "" {
+				return space + ":" + c.attrs["ri:content-title"]
+			}
+			return c.attrs["ri:content-title"]
+		case "ri:space":
+			return c.attrs["ri:space-key"]
+		}
+	}
+	return ""
+}
+
+// storageLink converts a link to a page, attachment, space or user
+func storageLink(n *storageNode) []*markdown.Node {
+	if user := n.child("ri:user"); user != nil {
+		name := user.attrs["ri:username"]
+		if name == "" {
+			name = user.attrs["ri:userkey"]
+		}
+		return []*markdown.Node{{Kind: markdown.Text, Text: "@" + name}}
+	}
+
+	target := storageResource(n)
+	if anchor := n.attrs["ac:anchor"]; anchor != "" {
+		target += "#" + anchor
+	}
+
+	var label []*markdown.Node
+	if body := n.child("ac:link-body"); body != nil {
+		label = storageInlineChildren(body)
+	} else if body := n.child("ac:plain-text-link-body"); body != nil {
+		label = []*markdown.Node{{Kind: markdown.Text, Text: body.textContent()}}
+	}
+	if len(trimInlines(label)) == 0 {
+		label = []*markdown.Node{{Kind: markdown.Text, Text: target}}
+	}
+
+	return []*markdown.Node{{Kind: markdown.Link, URL: target, Children: label}}
+}
+
+// storageInlineMacro converts an inline macro such as a status lozenge or a Jira issue
+func storageInlineMacro(n *storageNode) []*markdown.Node {
+	params := n.parameters()
+	switch n.attrs["ac:name"] {
+	case "status":
+		return []*markdown.Node{{Kind: markdown.Strong, Children: []*markdown.Node{{Kind: markdown.Text, Text: params["title"]}}}}
+	case "jira":
+		return []*markdown.Node{{Kind: markdown.Text, Text: params["key"]}}
+	}
+
+	if body := n.child("ac:rich-text-body"); body != nil {
+		return storageInlineChildren(body)
+	}
+	if body := n.child("ac:plain-text-body"); body != nil {
+		return []*markdown.Node{{Kind: markdown.Text, Text: body.textContent()}}
+	}
+	return nil
+}
+
+// trimInlines removes the leading and trailing whitespace of inline content and
+// the whitespace around line breaks
+func trimInlines(nodes []*markdown.Node) []*markdown.Node {
+	var trimmed []*markdown.Node
+	for i, n := range nodes {
+		if n.Kind == markdown.Text {
+			text := n.Text
+			if len(trimmed) == 0 || trimmed[len(trimmed)-1].Kind == markdown.LineBreak {
+				text = strings.TrimLeft(text, " ")
+			}
+			if i == len(nodes)-1 || nodes[i+1].Kind == markdown.LineBreak {
+				text = strings.TrimRight(text, " ")
+			}
+			if text == "" {
+				continue
+			}
+			n = &markdown.Node{Kind: markdown.Text, Text: text}
+		}
+		trimmed = append(trimmed, n)
+	}
+
+	for len(trimmed) > 0 && trimmed[len(trimmed)-1].Kind == markdown.LineBreak {
+		trimmed = trimmed[:len(trimmed)-1]
+	}
+	return trimmed
+}
@@ -0,0 +1,152 @@
+package confluence
+
+import "testing"
+
+func TestMarkdownToStorageRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		md      string
+		storage string
+		// back is the expected Markdown after the round trip if it differs from md
+		back string
+	}{
+		{
+			name:    "headings",
+			md:      "# Title\n\n## Sub *em*",
+			storage: `<h1>Title</h1><h2>Sub <em>em</em></h2>`,
+		},
+		{
+			name:    "bold at line start",
+			md:      "**Note:** read",
+			storage: `<p><strong>Note:</strong> read</p>`,
+		},
+		{
+			name:    "table with alignment",
+			md:      "| A | B |\n|:--|--:|\n| 1 | `x` |",
+			storage: `<table><tbody><tr><th>A</th><th style="text-align: right;">B</th></tr><tr><td>1</td><td style="text-align: right;"><code>x</code></td></tr></tbody></table>`,
+			back:    "| A | B |\n| --- | ---: |\n| 1 | `x` |",
+		},
+		{
+			name:    "code macro",
+			md:      "```go\nfmt.Println(\"hi\")\n```",
+			storage: `<ac:structured-macro ac:name="code"><ac:parameter ac:name="language">go</ac:parameter><ac:plain-text-body><![CDATA[fmt.Println("hi")]]></ac:plain-text-body></ac:structured-macro>`,
+		},
+		{
+			name:    "code macro with CDATA terminator",
+			md:      "```go\nfmt.Println(\"]]>\")\n```",
+			storage: `<ac:structured-macro ac:name="code"><ac:parameter ac:name="language">go</ac:parameter><ac:plain-text-body><![CDATA[fmt.Println("]]]]><![CDATA[>")]]></ac:plain-text-body></ac:structured-macro>`,
+		},
+		{
+			name:    "task list",
+			md:      "- [ ] todo\n- [x] done",
+			storage: `<ac:task-list><ac:task><ac:task-status>incomplete</ac:task-status><ac:task-body>todo</ac:task-body></ac:task><ac:task><ac:task-status>complete</ac:task-status><ac:task-body>done</ac:task-body></ac:task></ac:task-list>`,
+		},
+		{
+			name:    "nested lists",
+			md:      "1. one\n2. two\n   - nested",
+			storage: `<ol><li>one</li><li>two<ul><li>nested</li></ul></li></ol>`,
+		},
+		{
+			name:    "attachment image",
+			md:      "![diagram](diagram.png)",
+			storage: `<p><ac:image ac:alt="diagram"><ri:attachment ri:filename="diagram.png"/></ac:image></p>`,
+		},
+		{
+			name:    "URL image",
+			md:      "![logo](https://example.com/logo.png)",
+			storage: `<p><ac:image ac:alt="logo"><ri:url ri:value="https://example.com/logo.png"/></ac:image></p>`,
+		},
+		{
+			name:    "page link",
+			md:      "[Other page](<Other Page>)",
+			storage: `<p><ac:link><ri:page ri:content-title="Other Page"/><ac:link-body>Other page</ac:link-body></ac:link></p>`,
+		},
+		{
+			name:    "page link in space with anchor",
+			md:      "[Plan](DEV:Roadmap#q3)",
+			storage: `<p><ac:link ac:anchor="q3"><ri:page ri:space-key="DEV" ri:content-title="Roadmap"/><ac:link-body>Plan</ac:link-body></ac:link></p>`,
+		},
+		{
+			name:    "URL link",
+			md:      "[site](https://example.com)",
+			storage: `<p><a href="https://example.com">site</a></p>`,
+		},
+		{
+			name:    "XML escaping",
+			md:      `a < b & c > "d"`,
+			storage: `<p>a &lt; b &amp; c &gt; &#34;d&#34;</p>`,
+		},
+		{
+			name:    "XML escaping in inline code",
+			md:      "`a<b`",
+			storage: `<p><code>a&lt;b</code></p>`,
+		},
+		{
+			name:    "block quote",
+			md:      "> quote",
+			storage: `<blockquote><p>quote</p></blockquote>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := MarkdownToStorage(tt.md)
+			if storage != tt.storage {
+				t.Errorf("MarkdownToStorage(%q) = %q, want %q", tt.md, storage, tt.storage)
+			}
+
+			back, err := StorageToMarkdown(storage)
+			if err != nil {
+				t.Fatalf("StorageToMarkdown(%q) failed: %v", storage, err)
+			}
+			want := tt.back
+			if want == "" {
+				want = tt.md
+			}
+			if back != want {
+				t.Errorf("StorageToMarkdown(%q) = %q, want %q", storage, back, want)
+			}
+		})
+	}
+}
+
+func TestStorageToMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage string
+		md      string
+	}{
+		{
+			name:    "page link without body",
+			storage: `<p><ac:link><ri:page ri:content-title="Other Page"/></ac:link></p>`,
+			md:      "[Other Page](<Other Page>)",
+		},
+		{
+			name:    "split CDATA sections",
+			storage: `<ac:structured-macro ac:name="code"><ac:plain-text-body><![CDATA[a]]]]><![CDATA[>b]]></ac:plain-text-body></ac:structured-macro>`,
+			md:      "```\na]]>b\n```",
+		},
+		{
+			name:    "attachment image without alt text",
+			storage: `<p><ac:image><ri:attachment ri:filename="shot.png"/></ac:image></p>`,
+			md:      "![](shot.png)",
+		},
+		{
+			name:    "entities",
+			storage: `<p>a &amp; b &lt;c&gt;</p>`,
+			md:      `a & b \<c>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md, err := StorageToMarkdown(tt.storage)
+			if err != nil {
+				t.Fatalf("StorageToMarkdown(%q) failed: %v", tt.storage, err)
+			}
+			if md != tt.md {
+				t.Errorf("StorageToMarkdown(%q) = %q, want %q", tt.storage, md, tt.md)
+			}
+		})
+	}
+}
@@ -1,7 +1,31 @@
 package confluence
 
+import "atlassian-dc-mcp-go/internal/client"
+
 // PaginationInput represents pagination parameters
 type PaginationInput struct {
-	Start int `json:"start,omitempty" jsonschema:"The starting index of the returned results"`
-	Limit int `json:"limit,omitempty" jsonschema:"The limit of the number of results to return"`
+	Start    int  `json:"start,omitempty" jsonschema:"The starting index of the returned results"`
+	Limit    int  `json:"limit,omitempty" jsonschema:"The limit of the number of results to return"`
+	FetchAll bool `json:"fetchAll,omitempty" jsonschema:"Whether to fetch all pages and return their items merged, up to maxItems"`
+	MaxItems int  `json:"maxItems,omitempty" jsonschema:"The maximum number of items to return when fetchAll is set (default: 500)"`
+}
+
+// PagingStyle returns how Confluence pages its results
+func (p PaginationInput) PagingStyle() client.PagingStyle {
+	return client.PagingLinks
+}
+
+// FetchAllPages returns whether all pages are requested and the maximum number of items
+func (p PaginationInput) FetchAllPages() (bool, int) {
+	return p.FetchAll, p.MaxItems
+}
+
+// StartPage returns the first page requested by the input
+func (p PaginationInput) StartPage() client.Page {
+	return client.Page{Start: p.Start}
+}
+
+// SetPage sets the page requested by the input
+func (p *PaginationInput) SetPage(page client.Page) {
+	p.Start = page.Start
 }
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// dryRunEnabled is the server-wide dry_run setting
+var dryRunEnabled atomic.Bool
+
+// InitDryRun sets whether write tools run in dry-run mode by default
+func InitDryRun(enabled bool) {
+	dryRunEnabled.Store(enabled)
+}
+
+// DryRunEnabled reports whether write tools run in dry-run mode by default
+func DryRunEnabled() bool {
+	return dryRunEnabled.Load()
+}
+
+// PlannedRequest describes a write request that was not sent because of dry-run mode
+type PlannedRequest struct {
+	Service string          `json:"service"`
+	Method  string          `json:"method"`
+	URL     string          `json:"url"`
+	Body    json.RawMessage `json:"body,omitempty"`
+}
+
+// DryRunPlan collects the write requests planned by a tool call in dry-run mode
+type DryRunPlan struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// dryRunKey is the context key of the DryRunPlan
+type dryRunKey struct{}
+
+// WithDryRun returns a context in which write requests are collected instead of sent.
+// GET requests are still sent, so that lookups and validations keep working.
+func WithDryRun(ctx context.Context) (context.Context, *DryRunPlan) {
+	plan := &DryRunPlan{}
+	return context.WithValue(ctx, dryRunKey{}, plan), plan
+}
+
+// IsDryRun reports whether write requests sent with the context are only planned
+func IsDryRun(ctx context.Context) bool {
+	_, ok := ctx.Value(dryRunKey{}).(*DryRunPlan)
+	return ok
+}
+
+// Requests returns the planned requests in the order they were built
+func (p *DryRunPlan) Requests() []PlannedRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PlannedRequest{}, p.requests...)
+}
+
+// planRequest adds a write request to the dry-run plan of the context.
+// It reports whether the request was planned and must not be sent.
+func planRequest(ctx context.Context, client *BaseClient, req *http.Request, body []byte) bool {
+	plan, ok := ctx.Value(dryRunKey{}).(*DryRunPlan)
+	if !ok || req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return false
+	}
+
+	planned := PlannedRequest{
+		Service: client.Name,
+		Method:  req.Method,
+		URL:     req.URL.String(),
+	}
+	if len(body) > 0 {
+		if contentType := req.Header.Get("Content-Type"); strings.HasPrefix(contentType, "multipart/") {
+			// Multipart bodies carry file content, which is only summarized
+			planned.Body, _ = json.Marshal(fmt.Sprintf("%s body of %d bytes", contentType, len(body)))
+		} else if json.Valid(body) {
+			planned.Body = body
+		} else {
+			planned.Body, _ = json.Marshal(string(body))
+		}
+	}
+
+	plan.mu.Lock()
+	defer plan.mu.Unlock()
+
+	plan.requests = append(plan.requests, planned)
+	return true
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 	"go.uber.org/zap"
 
+	"atlassian-dc-mcp-go/internal/metrics"
+	"atlassian-dc-mcp-go/internal/tracing"
 	"atlassian-dc-mcp-go/internal/types"
 	"atlassian-dc-mcp-go/internal/utils/logging"
 )
@@ -84,6 +86,8 @@ func NewRetryableHTTPClient(config *HTTPClientConfig, transport http.RoundTrippe
 	client.RetryMax = config.RetryAttempts
 	client.RetryWaitMin = config.RetryDelay
 	client.RetryWaitMax = config.RetryDelay * 10
+	client.Backoff = rateLimitBackoff
+	client.CheckRetry = rateLimitRetryPolicy
 	client.Logger = nil // Disable logging, we'll handle it ourselves
 
 	return client
@@ -91,12 +95,37 @@ func NewRetryableHTTPClient(config *HTTPClientConfig, transport http.RoundTrippe
 
 // ExecuteRequest executes an HTTP request with the provided parameters.
 // It builds the request and executes it with retry logic.
-func ExecuteRequest(ctx context.Context, client *BaseClient, method string, pathSegments []any, queryParams map[string][]string, body []byte, accept Accept, result any) error {
+func ExecuteRequest(ctx context.Context, client *BaseClient, method string, pathSegments []any, queryParams map[string][]string, body []byte, accept Accept, result any) (err error) {
 	// Build the HTTP request
 	req, err := buildHttpRequest(method, client.Config.URL, pathSegments, queryParams, body, accept)
 	if err != nil {
 		return fmt.Errorf("failed to build request: %w", err)
 	}
+	setContextHeaders(ctx, req)
+
+	// In dry-run mode write requests are only planned
+	if planRequest(ctx, client, req, body) {
+		return nil
+	}
+
+	// Trace the request including cache lookups and retries
+	ctx, span, rt := startRequestSpan(ctx, client, req)
+	statusCode := 0
+	defer func() { endRequestSpan(span, rt, statusCode, err) }()
+
+	// Writes invalidate cached responses of the same resource
+	defer client.invalidateCache(req)
+
+	// Serve GET requests from the response cache while they are fresh,
+	// and revalidate expired entries with a conditional request
+	cached := client.cacheRequest(ctx, req)
+	if cached != nil {
+		if cachedBody, ok := cached.fresh(); ok {
+			span.SetAttributes(tracing.AttrCacheHit.Bool(true))
+			return decodeResponse(ctx, client, cachedBody, result)
+		}
+		cached.addValidators(req)
+	}
 
 	// Convert http.Request to retryablehttp.Request
 	retryReq, err := retryablehttp.FromRequest(req.WithContext(ctx))
@@ -105,37 +134,103 @@ func ExecuteRequest(ctx context.Context, client *BaseClient, method string, path
 	}
 
 	// Execute the request with retry mechanism
+	start := time.Now()
 	resp, err := client.HTTPClient.Do(retryReq)
 	if err != nil {
+		observeRequest(ctx, client, req, 0, start)
 		return fmt.Errorf("[%s] request failed: %w", client.Name, err)
 	}
 	defer resp.Body.Close()
+	statusCode = resp.StatusCode
+	observeRequest(ctx, client, req, resp.StatusCode, start)
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		if cachedBody, ok := cached.notModified(); ok {
+			return decodeResponse(ctx, client, cachedBody, result)
+		}
+	}
 
 	// Check for HTTP errors
 	if err := HandleHTTPError(resp, client.Name); err != nil {
 		return err
 	}
 
-	// Decode response if needed
-	if result != nil {
-		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-			return fmt.Errorf("[%s] failed to decode response: %w", client.Name, err)
+	// Skip reading the body if nobody needs it
+	if result == nil && cached == nil {
+		return nil
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("[%s] failed to read response: %w", client.Name, err)
+	}
+
+	if cached != nil {
+		cached.store(respBody, resp.Header)
+	}
+
+	return decodeResponse(ctx, client, respBody, result)
+}
+
+// observeRequest records the outcome of a request in the metrics and the request recorder.
+// A status code of 0 means that no response was received.
+func observeRequest(ctx context.Context, client *BaseClient, req *http.Request, statusCode int, start time.Time) {
+	metrics.ObserveUpstreamRequest(client.Name, req.Method, statusCode, time.Since(start))
+	recordRequest(ctx, client, req, statusCode)
+}
+
+// decodeResponse decodes a buffered response body into result and records how
+// many bytes pruning saved. Responses decoded into a map or a slice are pruned
+// with a token filter before they are decoded, so that pruned values are never
+// allocated; the saved bytes include the whitespace between their tokens. An
+// empty body, as sent with 204 No Content, leaves result unchanged.
+func decodeResponse(ctx context.Context, client *BaseClient, body []byte, result any) error {
+	if result == nil || len(body) == 0 {
+		return nil
+	}
+
+	pruned := body
+	switch result.(type) {
+	case *map[string]any, *[]any:
+		if matcher := pruneMatcherFor(ctx, client.Name); !matcher.disabled {
+			// Invalid JSON is left to the decoder to report
+			if filtered, err := filterJSON(matcher, body); err == nil {
+				defer releaseFilterBuffer(filtered)
+				pruned = filtered
+			}
 		}
 	}
 
-	Prune(result)
-	//PruneMap(result)
+	if err := json.Unmarshal(pruned, result); err != nil {
+		return fmt.Errorf("[%s] failed to decode response: %w", client.Name, err)
+	}
+
+	metrics.ObservePrune(client.Name, len(body), len(pruned))
 	return nil
 }
 
 // ExecuteStream executes an HTTP request and returns a stream of the response body.
 // It builds the request and executes it with retry logic and timeout.
-func ExecuteStream(ctx context.Context, client *BaseClient, method string, pathSegments []any, queryParams map[string][]string, body []byte, accept Accept, timeout time.Duration) (io.ReadCloser, error) {
+func ExecuteStream(ctx context.Context, client *BaseClient, method string, pathSegments []any, queryParams map[string][]string, body []byte, accept Accept, timeout time.Duration) (stream io.ReadCloser, err error) {
 	// Build the HTTP request
 	req, err := buildHttpRequest(method, client.Config.URL, pathSegments, queryParams, body, accept)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build request: %w", err)
 	}
+	setContextHeaders(ctx, req)
+
+	// In dry-run mode write requests are only planned
+	if planRequest(ctx, client, req, body) {
+		return http.NoBody, nil
+	}
+
+	// Trace the request until the response headers are received
+	ctx, span, rt := startRequestSpan(ctx, client, req)
+	statusCode := 0
+	defer func() { endRequestSpan(span, rt, statusCode, err) }()
+
+	// Writes invalidate cached responses of the same resource
+	defer client.invalidateCache(req)
 
 	// Create a context with timeout
 	if timeout > 0 {
@@ -152,10 +247,14 @@ func ExecuteStream(ctx context.Context, client *BaseClient, method string, pathS
 	}
 
 	// Execute the request
+	start := time.Now()
 	resp, err := client.HTTPClient.Do(retryableReq)
 	if err != nil {
+		observeRequest(ctx, client, req, 0, start)
 		return nil, fmt.Errorf("[%s] request failed: %w", client.Name, err)
 	}
+	statusCode = resp.StatusCode
+	observeRequest(ctx, client, req, resp.StatusCode, start)
 
 	// Check for non-2xx status codes
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
@@ -227,10 +326,19 @@ func HandleHTTPError(resp *http.Response, service string, opts ...HTTPErrorHandl
 			Code:    "NOT_FOUND",
 			Message: fmt.Sprintf("[%s] not found: %s", service, bodyString),
 		}
+	case http.StatusConflict:
+		return &types.Error{
+			Code:    "CONFLICT",
+			Message: fmt.Sprintf("[%s] conflict: %s", service, bodyString),
+		}
 	case http.StatusTooManyRequests:
+		message := fmt.Sprintf("[%s] too many requests: %s", service, bodyString)
+		if wait, ok := retryDelay(resp); ok && wait > 0 {
+			message = fmt.Sprintf("[%s] too many requests, retry after %s: %s", service, wait.Round(time.Second), bodyString)
+		}
 		return &types.Error{
 			Code:    "TOO_MANY_REQUESTS",
-			Message: fmt.Sprintf("[%s] too many requests: %s", service, bodyString),
+			Message: message,
 		}
 	case http.StatusInternalServerError:
 		return &types.Error{
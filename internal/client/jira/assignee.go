@@ -0,0 +1,61 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// AssignIssue assigns an issue to a user, to the default assignee of the project
+// or to nobody. Users can be given by username or display name.
+//
+// Parameters:
+//   - input: AssignIssueInput containing issueKey and assignee
+//
+// Returns:
+//   - types.MapOutput: The result of the operation with the resolved assignee
+//   - error: An error if the request fails
+func (c *JiraClient) AssignIssue(ctx context.Context, input AssignIssueInput) (types.MapOutput, error) {
+	output := types.MapOutput{"success": true}
+
+	// Jira assigns the default assignee for the name -1 and unassigns for a null name
+	var name any
+	switch input.Assignee {
+	case AssigneeNone:
+		name = nil
+	case AssigneeDefault:
+		name = "-1"
+	default:
+		user, username, err := c.resolveUsername(ctx, input.Assignee)
+		if err != nil {
+			return nil, err
+		}
+		name = username
+		output["assignee"] = user
+	}
+
+	jsonPayload, err := json.Marshal(types.MapOutput{"name": name})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPut,
+		[]any{"rest", "api", "2", "issue", input.IssueKey, "assignee"},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,17 @@
+package jira
+
+// Special assignees of AssignIssueInput
+const (
+	// AssigneeCurrentUser assigns the issue to the user of the token
+	AssigneeCurrentUser = "currentUser"
+	// AssigneeDefault assigns the issue to the default assignee of the project or component
+	AssigneeDefault = "default"
+	// AssigneeNone unassigns the issue
+	AssigneeNone = "none"
+)
+
+// AssignIssueInput represents the input parameters for assigning an issue
+type AssignIssueInput struct {
+	IssueKey string `json:"issueKey" jsonschema:"required,The key of the issue to assign"`
+	Assignee string `json:"assignee" jsonschema:"required,The username or display name of the user to assign. Use currentUser to assign the issue to yourself, default for the default assignee of the project, or none to unassign it"`
+}
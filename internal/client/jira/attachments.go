@@ -0,0 +1,238 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// Encodings of attachment content
+const (
+	EncodingText   = "text"
+	EncodingBase64 = "base64"
+)
+
+// textMimeTypes are the media types outside of text/* whose content is text
+var textMimeTypes = map[string]bool{
+	"application/json":       true,
+	"application/xml":        true,
+	"application/javascript": true,
+	"application/x-yaml":     true,
+	"application/yaml":       true,
+	"application/x-sh":       true,
+	"application/sql":        true,
+	"application/csv":        true,
+}
+
+// AttachmentContent is the downloaded content of an attachment
+type AttachmentContent struct {
+	ID       string
+	Filename string
+	MimeType string
+	// URL is the download URL of the attachment
+	URL  string
+	Data []byte
+	// IsText is set if the content is UTF-8 text
+	IsText bool
+}
+
+// GetAttachments retrieves the attachments of an issue.
+//
+// Parameters:
+//   - input: GetAttachmentsInput containing issueKey
+//
+// Returns:
+//   - []types.MapOutput: The attachments metadata
+//   - error: An error if the request fails
+func (c *JiraClient) GetAttachments(ctx context.Context, input GetAttachmentsInput) ([]types.MapOutput, error) {
+	queryParams := url.Values{}
+	queryParams.Set("fields", "attachment")
+
+	// Decode into a generic map so that the prune rules apply to the attachments
+	var output types.MapOutput
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "issue", input.IssueKey},
+		queryParams,
+		nil,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, _ := output["fields"].(map[string]any)
+	return mapItems(fields["attachment"]), nil
+}
+
+// mapItems returns the objects of a decoded JSON array
+func mapItems(value any) []types.MapOutput {
+	items, _ := value.([]any)
+	outputs := make([]types.MapOutput, 0, len(items))
+	for _, item := range items {
+		if item, ok := item.(map[string]any); ok {
+			outputs = append(outputs, item)
+		}
+	}
+	return outputs
+}
+
+// GetAttachmentContent downloads the content of an attachment.
+// Attachments larger than the configured attachment_max_size_mb are rejected.
+//
+// Parameters:
+//   - input: GetAttachmentContentInput containing attachmentId
+//
+// Returns:
+//   - *AttachmentContent: The attachment content with its file name and MIME type
+//   - error: An error if the request fails or the attachment is too large
+func (c *JiraClient) GetAttachmentContent(ctx context.Context, input GetAttachmentContentInput) (*AttachmentContent, error) {
+	var metadata struct {
+		ID       string `json:"id"`
+		Filename string `json:"filename"`
+		MimeType string `json:"mimeType"`
+		Size     int64  `json:"size"`
+	}
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "attachment", input.AttachmentId},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&metadata,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSize := int64(c.Config.AttachmentMaxSizeMB) * 1024 * 1024
+	if metadata.Size > maxSize {
+		return nil, fmt.Errorf("attachment %s is %d bytes, which exceeds the maximum size of %d MB", metadata.Filename, metadata.Size, c.Config.AttachmentMaxSizeMB)
+	}
+
+	stream, err := client.ExecuteStream(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"secure", "attachment", input.AttachmentId, metadata.Filename},
+		nil,
+		nil,
+		client.AcceptAny,
+		0,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	// Read one byte more than allowed to detect attachments that grew since the metadata was read
+	data, err := io.ReadAll(io.LimitReader(stream, maxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("attachment %s exceeds the maximum size of %d MB", metadata.Filename, c.Config.AttachmentMaxSizeMB)
+	}
+
+	mimeType, isText := detectMimeType(metadata.MimeType, data)
+	return &AttachmentContent{
+		ID:       input.AttachmentId,
+		Filename: metadata.Filename,
+		MimeType: mimeType,
+		URL:      strings.TrimRight(c.Config.URL, "/") + "/secure/attachment/" + url.PathEscape(input.AttachmentId) + "/" + url.PathEscape(metadata.Filename),
+		Data:     data,
+		IsText:   isText,
+	}, nil
+}
+
+// detectMimeType returns the MIME type of attachment content and whether it is text.
+// Generic types reported by Jira are replaced with the type sniffed from the content,
+// so that log files uploaded as application/octet-stream are read as text.
+func detectMimeType(reported string, data []byte) (string, bool) {
+	mimeType := reported
+	mediaType, _, _ := mime.ParseMediaType(reported)
+	if mediaType == "" || mediaType == "application/octet-stream" || mediaType == "binary/octet-stream" {
+		mimeType = http.DetectContentType(data)
+		mediaType, _, _ = mime.ParseMediaType(mimeType)
+	}
+
+	isText := strings.HasPrefix(mediaType, "text/") || textMimeTypes[mediaType] ||
+		strings.HasSuffix(mediaType, "+json") || strings.HasSuffix(mediaType, "+xml")
+	return mimeType, isText && utf8.Valid(data)
+}
+
+// AddAttachment adds an attachment to an issue.
+//
+// Parameters:
+//   - input: AddAttachmentInput containing issueKey, filename, content, and encoding
+//
+// Returns:
+//   - []types.MapOutput: The metadata of the created attachments
+//   - error: An error if the request fails
+func (c *JiraClient) AddAttachment(ctx context.Context, input AddAttachmentInput) ([]types.MapOutput, error) {
+	var content []byte
+	switch input.Encoding {
+	case "", EncodingText:
+		content = []byte(input.Content)
+	case EncodingBase64:
+		decoded, err := base64.StdEncoding.DecodeString(input.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 content: %w", err)
+		}
+		content = decoded
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q, expected %q or %q", input.Encoding, EncodingText, EncodingBase64)
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", input.Filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create multipart body: %w", err)
+	}
+	if _, err := part.Write(content); err != nil {
+		return nil, fmt.Errorf("failed to create multipart body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to create multipart body: %w", err)
+	}
+
+	// Jira rejects attachment uploads without the XSRF check override
+	ctx = client.WithHeaders(ctx, http.Header{
+		"Content-Type":      {writer.FormDataContentType()},
+		"X-Atlassian-Token": {"no-check"},
+	})
+
+	var outputs []any
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPost,
+		[]any{"rest", "api", "2", "issue", input.IssueKey, "attachments"},
+		nil,
+		body.Bytes(),
+		client.AcceptJSON,
+		&outputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapItems(outputs), nil
+}
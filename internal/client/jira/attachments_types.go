@@ -0,0 +1,19 @@
+package jira
+
+// GetAttachmentsInput represents the input parameters for getting the attachments of an issue
+type GetAttachmentsInput struct {
+	IssueKey string `json:"issueKey" jsonschema:"required,The key of the issue"`
+}
+
+// GetAttachmentContentInput represents the input parameters for getting the content of an attachment
+type GetAttachmentContentInput struct {
+	AttachmentId string `json:"attachmentId" jsonschema:"required,The ID of the attachment"`
+}
+
+// AddAttachmentInput represents the input parameters for adding an attachment to an issue
+type AddAttachmentInput struct {
+	IssueKey string `json:"issueKey" jsonschema:"required,The key of the issue"`
+	Filename string `json:"filename" jsonschema:"required,The file name of the attachment"`
+	Content  string `json:"content" jsonschema:"required,The content of the attachment"`
+	Encoding string `json:"encoding,omitempty" jsonschema:"The encoding of the content: text (default) or base64"`
+}
@@ -2,6 +2,8 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 
@@ -233,3 +235,214 @@ func (c *JiraClient) GetSprintIssues(ctx context.Context, input GetSprintIssuesI
 
 	return output, nil
 }
+
+// Sprint states that can be set by UpdateSprint
+const (
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// maxAgileIssues is the largest number of issues the Agile API moves or ranks in one request
+const maxAgileIssues = 50
+
+// CreateSprint creates a new future sprint on a board.
+//
+// Parameters:
+//   - input: CreateSprintInput containing boardId, name, goal, startDate, and endDate
+//
+// Returns:
+//   - types.MapOutput: The created sprint data
+//   - error: An error if the request fails
+func (c *JiraClient) CreateSprint(ctx context.Context, input CreateSprintInput) (types.MapOutput, error) {
+	payload := types.MapOutput{
+		"name":          input.Name,
+		"originBoardId": input.BoardId,
+	}
+	client.SetRequestBodyParam(payload, "goal", input.Goal)
+	client.SetRequestBodyParam(payload, "startDate", input.StartDate)
+	client.SetRequestBodyParam(payload, "endDate", input.EndDate)
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	var output types.MapOutput
+	if err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPost,
+		[]any{"rest", "agile", "1.0", "sprint"},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		&output,
+	); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// UpdateSprint updates the name, goal, dates or state of a sprint. Fields that are
+// not set are left unchanged.
+//
+// Parameters:
+//   - input: UpdateSprintInput containing sprintId, name, goal, startDate, endDate, and state
+//
+// Returns:
+//   - types.MapOutput: The updated sprint data
+//   - error: An error if the state is invalid or the request fails
+func (c *JiraClient) UpdateSprint(ctx context.Context, input UpdateSprintInput) (types.MapOutput, error) {
+	switch input.State {
+	case "", SprintStateActive, SprintStateClosed:
+	default:
+		return nil, fmt.Errorf("unsupported sprint state %q, expected %q or %q", input.State, SprintStateActive, SprintStateClosed)
+	}
+
+	payload := types.MapOutput{}
+	client.SetRequestBodyParam(payload, "name", input.Name)
+	client.SetRequestBodyParam(payload, "goal", input.Goal)
+	client.SetRequestBodyParam(payload, "startDate", input.StartDate)
+	client.SetRequestBodyParam(payload, "endDate", input.EndDate)
+	client.SetRequestBodyParam(payload, "state", input.State)
+	if len(payload) == 0 {
+		return nil, fmt.Errorf("no sprint fields to update")
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	// POST updates only the given fields, PUT would reset the others
+	var output types.MapOutput
+	if err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPost,
+		[]any{"rest", "agile", "1.0", "sprint", input.SprintId},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		&output,
+	); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// MoveIssuesToSprint moves issues to a sprint. Issues are moved in batches of 50.
+//
+// Parameters:
+//   - input: MoveIssuesToSprintInput containing sprintId and issueKeys
+//
+// Returns:
+//   - error: An error if a request fails
+func (c *JiraClient) MoveIssuesToSprint(ctx context.Context, input MoveIssuesToSprintInput) error {
+	return c.moveIssues(ctx, []any{"rest", "agile", "1.0", "sprint", input.SprintId, "issue"}, input.IssueKeys)
+}
+
+// MoveIssuesToBacklog moves issues to the backlog, removing them from their sprints.
+// Issues are moved in batches of 50.
+//
+// Parameters:
+//   - input: MoveIssuesToBacklogInput containing issueKeys
+//
+// Returns:
+//   - error: An error if a request fails
+func (c *JiraClient) MoveIssuesToBacklog(ctx context.Context, input MoveIssuesToBacklogInput) error {
+	return c.moveIssues(ctx, []any{"rest", "agile", "1.0", "backlog", "issue"}, input.IssueKeys)
+}
+
+// moveIssues posts the issues to an Agile API move endpoint in batches
+func (c *JiraClient) moveIssues(ctx context.Context, pathSegments []any, issueKeys []string) error {
+	if len(issueKeys) == 0 {
+		return fmt.Errorf("no issues to move")
+	}
+
+	for start := 0; start < len(issueKeys); start += maxAgileIssues {
+		batch := issueKeys[start:min(start+maxAgileIssues, len(issueKeys))]
+		jsonPayload, err := json.Marshal(types.MapOutput{"issues": batch})
+		if err != nil {
+			return fmt.Errorf("failed to marshal payload: %w", err)
+		}
+
+		if err := client.ExecuteRequest(
+			ctx,
+			c.BaseClient,
+			http.MethodPost,
+			pathSegments,
+			nil,
+			jsonPayload,
+			client.AcceptJSON,
+			nil,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RankIssues ranks issues before or after another issue, keeping their order.
+// Issues are ranked in batches of 50, each batch after the last issue of the previous one.
+//
+// Parameters:
+//   - input: RankIssuesInput containing issueKeys, rankBeforeIssue, rankAfterIssue, and rankCustomFieldId
+//
+// Returns:
+//   - []types.MapOutput: The issues that could not be ranked with their errors
+//   - error: An error if the input is invalid or a request fails
+func (c *JiraClient) RankIssues(ctx context.Context, input RankIssuesInput) ([]types.MapOutput, error) {
+	if len(input.IssueKeys) == 0 {
+		return nil, fmt.Errorf("no issues to rank")
+	}
+	if (input.RankBeforeIssue == "") == (input.RankAfterIssue == "") {
+		return nil, fmt.Errorf("exactly one of rankBeforeIssue and rankAfterIssue is required")
+	}
+
+	failed := []types.MapOutput{}
+	before, after := input.RankBeforeIssue, input.RankAfterIssue
+	for start := 0; start < len(input.IssueKeys); start += maxAgileIssues {
+		batch := input.IssueKeys[start:min(start+maxAgileIssues, len(input.IssueKeys))]
+
+		payload := types.MapOutput{"issues": batch}
+		client.SetRequestBodyParam(payload, "rankBeforeIssue", before)
+		client.SetRequestBodyParam(payload, "rankAfterIssue", after)
+		client.SetRequestBodyParam(payload, "rankCustomFieldId", input.RankCustomFieldId)
+
+		jsonPayload, err := json.Marshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+
+		// Jira answers 204 if all issues were ranked and 207 with the failed issues otherwise
+		var output types.MapOutput
+		if err := client.ExecuteRequest(
+			ctx,
+			c.BaseClient,
+			http.MethodPut,
+			[]any{"rest", "agile", "1.0", "issue", "rank"},
+			nil,
+			jsonPayload,
+			client.AcceptJSON,
+			&output,
+		); err != nil {
+			return nil, err
+		}
+
+		entries, _ := output["entries"].([]any)
+		for _, entry := range entries {
+			entry, _ := entry.(map[string]any)
+			if errs, _ := entry["errors"].([]any); len(errs) > 0 {
+				failed = append(failed, entry)
+			}
+		}
+
+		before, after = "", batch[len(batch)-1]
+	}
+
+	return failed, nil
+}
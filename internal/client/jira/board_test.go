@@ -0,0 +1,92 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// issueKeys returns n issue keys ABC-1 to ABC-n
+func issueKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("ABC-%d", i+1)
+	}
+	return keys
+}
+
+func TestMoveIssuesToSprintBatches(t *testing.T) {
+	var batches [][]string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/agile/1.0/sprint/7/issue" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Issues []string `json:"issues"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		batches = append(batches, body.Issues)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := c.MoveIssuesToSprint(context.Background(), MoveIssuesToSprintInput{SprintId: 7, IssueKeys: issueKeys(120)}); err != nil {
+		t.Fatalf("MoveIssuesToSprint failed: %v", err)
+	}
+	if len(batches) != 3 || len(batches[0]) != 50 || len(batches[2]) != 20 || batches[2][0] != "ABC-101" {
+		t.Errorf("unexpected batches of sizes %d", len(batches))
+	}
+}
+
+func TestRankIssues(t *testing.T) {
+	type rankRequest struct {
+		Issues          []string `json:"issues"`
+		RankBeforeIssue string   `json:"rankBeforeIssue"`
+		RankAfterIssue  string   `json:"rankAfterIssue"`
+	}
+	var requests []rankRequest
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body rankRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, body)
+
+		if len(requests) == 1 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultiStatus)
+		w.Write([]byte(`{"entries":[{"issueKey":"ABC-51","status":200},{"issueKey":"ABC-52","status":400,"errors":["Issue is not on the board"]}]}`))
+	})
+
+	failed, err := c.RankIssues(context.Background(), RankIssuesInput{IssueKeys: issueKeys(60), RankAfterIssue: "ABC-100"})
+	if err != nil {
+		t.Fatalf("RankIssues failed: %v", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(requests))
+	}
+	if requests[0].RankAfterIssue != "ABC-100" || requests[1].RankAfterIssue != "ABC-50" || requests[1].RankBeforeIssue != "" {
+		t.Errorf("second batch is not ranked after the first: %+v", requests[1])
+	}
+	if len(failed) != 1 || failed[0]["issueKey"] != "ABC-52" {
+		t.Errorf("failed = %v, want ABC-52", failed)
+	}
+}
+
+func TestRankIssuesRequiresOneTarget(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	for _, input := range []RankIssuesInput{
+		{IssueKeys: []string{"ABC-1"}},
+		{IssueKeys: []string{"ABC-1"}, RankBeforeIssue: "ABC-2", RankAfterIssue: "ABC-3"},
+	} {
+		if _, err := c.RankIssues(context.Background(), input); err == nil {
+			t.Errorf("RankIssues(%+v) succeeded, want an error", input)
+		}
+	}
+}
@@ -51,3 +51,41 @@ type GetSprintIssuesInput struct {
 	Fields        []string `json:"fields,omitempty" jsonschema:"The list of fields to return for each issue"`
 	Expand        string   `json:"expand,omitempty" jsonschema:"A comma-separated list of parameters to expand"`
 }
+
+// CreateSprintInput represents the input parameters for creating a sprint
+type CreateSprintInput struct {
+	BoardId   int    `json:"boardId" jsonschema:"required,The ID of the board to create the sprint on"`
+	Name      string `json:"name" jsonschema:"required,The name of the sprint"`
+	Goal      string `json:"goal,omitempty" jsonschema:"The goal of the sprint"`
+	StartDate string `json:"startDate,omitempty" jsonschema:"The start date of the sprint in ISO 8601 format, such as 2024-01-15T09:00:00.000+01:00"`
+	EndDate   string `json:"endDate,omitempty" jsonschema:"The end date of the sprint in ISO 8601 format"`
+}
+
+// UpdateSprintInput represents the input parameters for updating a sprint
+type UpdateSprintInput struct {
+	SprintId  int    `json:"sprintId" jsonschema:"required,The ID of the sprint to update"`
+	Name      string `json:"name,omitempty" jsonschema:"The new name of the sprint"`
+	Goal      string `json:"goal,omitempty" jsonschema:"The new goal of the sprint"`
+	StartDate string `json:"startDate,omitempty" jsonschema:"The new start date of the sprint in ISO 8601 format"`
+	EndDate   string `json:"endDate,omitempty" jsonschema:"The new end date of the sprint in ISO 8601 format"`
+	State     string `json:"state,omitempty" jsonschema:"Set to active to start a future sprint or closed to complete an active sprint. Starting a sprint requires start and end dates"`
+}
+
+// MoveIssuesToSprintInput represents the input parameters for moving issues to a sprint
+type MoveIssuesToSprintInput struct {
+	SprintId  int      `json:"sprintId" jsonschema:"required,The ID of the sprint to move the issues to"`
+	IssueKeys []string `json:"issueKeys" jsonschema:"required,The keys of the issues to move"`
+}
+
+// MoveIssuesToBacklogInput represents the input parameters for moving issues to the backlog
+type MoveIssuesToBacklogInput struct {
+	IssueKeys []string `json:"issueKeys" jsonschema:"required,The keys of the issues to move to the backlog, removing them from their sprints"`
+}
+
+// RankIssuesInput represents the input parameters for ranking issues
+type RankIssuesInput struct {
+	IssueKeys         []string `json:"issueKeys" jsonschema:"required,The keys of the issues to rank, in the order they should appear"`
+	RankBeforeIssue   string   `json:"rankBeforeIssue,omitempty" jsonschema:"The key of the issue to rank the issues before"`
+	RankAfterIssue    string   `json:"rankAfterIssue,omitempty" jsonschema:"The key of the issue to rank the issues after"`
+	RankCustomFieldId int      `json:"rankCustomFieldId,omitempty" jsonschema:"The ID of the rank custom field to use if there is more than one"`
+}
@@ -0,0 +1,250 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"atlassian-dc-mcp-go/internal/client"
+)
+
+// jiraTimeLayout is the layout of the timestamps returned by the Jira REST API
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// defaultChangelogPageSize is the number of changelog entries returned when maxResults is not set
+const defaultChangelogPageSize = 50
+
+// statusChange is a transition of an issue between two statuses
+type statusChange struct {
+	at       time.Time
+	from, to string
+}
+
+// GetIssueChangelog retrieves the change history of an issue as a timeline of field changes.
+// Jira Data Center returns the whole changelog with the issue, so the timeline is
+// filtered and paged after it has been fetched.
+//
+// Parameters:
+//   - input: GetIssueChangelogInput containing issueKey, fields, from, to, startAt, and maxResults
+//
+// Returns:
+//   - *IssueChangelog: The page of changelog entries and the time the issue spent in each status
+//   - error: An error if the request fails or the date range is invalid
+func (c *JiraClient) GetIssueChangelog(ctx context.Context, input GetIssueChangelogInput) (*IssueChangelog, error) {
+	from, err := parseChangelogTime(input.From, false)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseChangelogTime(input.To, true)
+	if err != nil {
+		return nil, err
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return nil, fmt.Errorf("invalid date range: %s is before %s", input.To, input.From)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Set("fields", "status,created")
+	queryParams.Set("expand", "changelog")
+
+	var issue struct {
+		Key    string `json:"key"`
+		Fields struct {
+			Created string `json:"created"`
+			Status  struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+		Changelog struct {
+			Histories []struct {
+				Author struct {
+					Name        string `json:"name"`
+					DisplayName string `json:"displayName"`
+				} `json:"author"`
+				Created string `json:"created"`
+				Items   []struct {
+					Field      string `json:"field"`
+					From       string `json:"from"`
+					FromString string `json:"fromString"`
+					To         string `json:"to"`
+					ToString   string `json:"toString"`
+				} `json:"items"`
+			} `json:"histories"`
+		} `json:"changelog"`
+	}
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "issue", input.IssueKey},
+		queryParams,
+		nil,
+		client.AcceptJSON,
+		&issue,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := time.Parse(jiraTimeLayout, issue.Fields.Created)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse creation time of %s: %w", issue.Key, err)
+	}
+
+	fields := map[string]bool{}
+	for _, field := range input.Fields {
+		fields[strings.ToLower(field)] = true
+	}
+	inRange := func(t time.Time) bool {
+		return (from.IsZero() || !t.Before(from)) && (to.IsZero() || !t.After(to))
+	}
+
+	entries := []ChangelogEntry{}
+	var changes []statusChange
+	for _, history := range issue.Changelog.Histories {
+		timestamp, err := time.Parse(jiraTimeLayout, history.Created)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse changelog time: %w", err)
+		}
+		author := history.Author.DisplayName
+		if author == "" {
+			author = history.Author.Name
+		}
+
+		for _, item := range history.Items {
+			if item.Field == "status" {
+				changes = append(changes, statusChange{at: timestamp, from: item.FromString, to: item.ToString})
+			}
+			if !inRange(timestamp) || (len(fields) > 0 && !fields[strings.ToLower(item.Field)]) {
+				continue
+			}
+
+			entry := ChangelogEntry{
+				Field:     item.Field,
+				From:      item.FromString,
+				To:        item.ToString,
+				Author:    author,
+				Timestamp: history.Created,
+			}
+			// Fields without display values, such as links, only have IDs
+			if entry.From == "" {
+				entry.From = item.From
+			}
+			if entry.To == "" {
+				entry.To = item.To
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].at.Before(changes[j].at) })
+
+	maxResults := input.MaxResults
+	if input.FetchAll {
+		// The whole changelog is already fetched, so all pages are a single page
+		maxResults = input.MaxItems
+		if maxResults <= 0 {
+			maxResults = client.DefaultMaxItems
+		}
+	}
+	if maxResults <= 0 {
+		maxResults = defaultChangelogPageSize
+	}
+	startAt := min(max(0, input.StartAt), len(entries))
+	end := min(startAt+maxResults, len(entries))
+
+	return &IssueChangelog{
+		IssueKey:     issue.Key,
+		StartAt:      startAt,
+		MaxResults:   maxResults,
+		Total:        len(entries),
+		Entries:      entries[startAt:end],
+		TimeInStatus: timeInStatus(created, issue.Fields.Status.Name, changes, from, to, time.Now()),
+	}, nil
+}
+
+// parseChangelogTime parses a date (in UTC) or an RFC 3339 time. A date as the end
+// of a range includes the whole day.
+func parseChangelogTime(s string, end bool) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD or RFC 3339", s)
+	}
+	if end {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return t, nil
+}
+
+// timeInStatus sums the time an issue spent in each status between its creation
+// and now, counting only the time within the range from and to if they are set.
+// Statuses are listed in the order the issue first entered them.
+func timeInStatus(created time.Time, current string, changes []statusChange, from, to, now time.Time) []StatusDuration {
+	durations := []StatusDuration{}
+	index := map[string]int{}
+	add := func(status string, start, end time.Time) {
+		if !from.IsZero() && start.Before(from) {
+			start = from
+		}
+		if !to.IsZero() && end.After(to) {
+			end = to
+		}
+		if !end.After(start) {
+			return
+		}
+
+		i, ok := index[status]
+		if !ok {
+			i = len(durations)
+			index[status] = i
+			durations = append(durations, StatusDuration{Status: status})
+		}
+		durations[i].Seconds += int64(end.Sub(start) / time.Second)
+		durations[i].Periods++
+	}
+
+	status, start := current, created
+	if len(changes) > 0 {
+		status = changes[0].from
+	}
+	for _, change := range changes {
+		add(status, start, change.at)
+		status, start = change.to, change.at
+	}
+	add(status, start, now)
+
+	for i := range durations {
+		durations[i].Duration = formatDuration(time.Duration(durations[i].Seconds) * time.Second)
+	}
+	return durations
+}
+
+// formatDuration formats a duration in days, hours and minutes, as in "2d 4h 30m"
+func formatDuration(d time.Duration) string {
+	minutes := int64(d.Round(time.Minute) / time.Minute)
+	days, hours := minutes/(24*60), minutes/60%24
+	minutes %= 60
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", days))
+	}
+	if hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+	}
+	if minutes > 0 || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,71 @@
+package jira
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimeInStatus(t *testing.T) {
+	day := func(d, h int) time.Time { return time.Date(2025, 1, d, h, 0, 0, 0, time.UTC) }
+	changes := []statusChange{
+		{at: day(2, 0), from: "Open", to: "In Progress"},
+		{at: day(2, 6), from: "In Progress", to: "Open"},
+		{at: day(3, 0), from: "Open", to: "In Progress"},
+		{at: day(4, 12), from: "In Progress", to: "Done"},
+	}
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     []StatusDuration
+	}{
+		{
+			name: "whole history",
+			want: []StatusDuration{
+				{Status: "Open", Duration: "1d 18h", Seconds: 42 * 3600, Periods: 2},
+				{Status: "In Progress", Duration: "1d 18h", Seconds: 42 * 3600, Periods: 2},
+				{Status: "Done", Duration: "12h", Seconds: 12 * 3600, Periods: 1},
+			},
+		},
+		{
+			name: "range", from: day(2, 3), to: day(3, 12),
+			want: []StatusDuration{
+				{Status: "In Progress", Duration: "15h", Seconds: 15 * 3600, Periods: 2},
+				{Status: "Open", Duration: "18h", Seconds: 18 * 3600, Periods: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := timeInStatus(day(1, 0), "Done", changes, tt.from, tt.to, day(5, 0))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("timeInStatus = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseChangelogTime(t *testing.T) {
+	end, err := parseChangelogTime("2025-01-02", true)
+	if err != nil || !end.Equal(time.Date(2025, 1, 2, 23, 59, 59, 999999999, time.UTC)) {
+		t.Errorf("end of day = %v, %v", end, err)
+	}
+	if _, err := parseChangelogTime("02.01.2025", false); err == nil {
+		t.Error("expected an error for an invalid date")
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                             "0m",
+		90 * time.Minute:              "1h 30m",
+		52*time.Hour + 30*time.Minute: "2d 4h 30m",
+		48*time.Hour + 20*time.Second: "2d",
+	} {
+		if got := formatDuration(d); got != want {
+			t.Errorf("formatDuration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
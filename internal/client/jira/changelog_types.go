@@ -0,0 +1,39 @@
+package jira
+
+// GetIssueChangelogInput represents the input parameters for getting the changelog of an issue
+type GetIssueChangelogInput struct {
+	PaginationInput
+	IssueKey string   `json:"issueKey" jsonschema:"required,The key of the issue"`
+	Fields   []string `json:"fields,omitempty" jsonschema:"Return only changes of these fields, such as status or assignee"`
+	From     string   `json:"from,omitempty" jsonschema:"Return only changes made at or after this date or time, as YYYY-MM-DD or RFC 3339"`
+	To       string   `json:"to,omitempty" jsonschema:"Return only changes made at or before this date or time, as YYYY-MM-DD or RFC 3339"`
+}
+
+// ChangelogEntry is a change of a single field of an issue
+type ChangelogEntry struct {
+	Field     string `json:"field"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Author    string `json:"author"`
+	Timestamp string `json:"timestamp"`
+}
+
+// StatusDuration is the time an issue spent in a status
+type StatusDuration struct {
+	Status   string `json:"status"`
+	Duration string `json:"duration"`
+	Seconds  int64  `json:"seconds"`
+	// Periods is the number of times the issue entered the status
+	Periods int `json:"periods"`
+}
+
+// IssueChangelog is the normalized changelog of an issue
+type IssueChangelog struct {
+	IssueKey   string           `json:"issueKey"`
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	Entries    []ChangelogEntry `json:"entries"`
+	// TimeInStatus is computed from the whole status history, limited to the date range
+	TimeInStatus []StatusDuration `json:"timeInStatus"`
+}
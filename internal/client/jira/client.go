@@ -9,6 +9,8 @@ import (
 // JiraClient represents a client for interacting with Jira Data Center APIs
 type JiraClient struct {
 	*client.BaseClient
+
+	fields *fieldRegistry
 }
 
 // NewJiraClient creates a new Jira client with the provided configuration.
@@ -20,5 +22,6 @@ func NewJiraClient(config *config.ClientConfig) (*JiraClient, error) {
 
 	return &JiraClient{
 		BaseClient: baseClient,
+		fields:     &fieldRegistry{},
 	}, nil
 }
@@ -0,0 +1,29 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"atlassian-dc-mcp-go/internal/config"
+	"atlassian-dc-mcp-go/internal/utils/logging"
+)
+
+func TestMain(m *testing.M) {
+	logging.InitLogger(&logging.Config{Level: "error"})
+	os.Exit(m.Run())
+}
+
+// newTestClient creates a client for a test server that serves handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *JiraClient {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := NewJiraClient(&config.ClientConfig{URL: server.URL, Token: "token", Timeout: 5})
+	if err != nil {
+		t.Fatalf("NewJiraClient failed: %v", err)
+	}
+	return c
+}
@@ -14,12 +14,16 @@ import (
 // GetComments retrieves comments for a specific issue.
 //
 // Parameters:
-//   - input: GetCommentsInput containing issueKey, startAt, maxResults, expand, and orderBy
+//   - input: GetCommentsInput containing issueKey, startAt, maxResults, expand, orderBy, and format
 //
 // Returns:
 //   - types.MapOutput: The comments data
 //   - error: An error if the request fails
 func (c *JiraClient) GetComments(ctx context.Context, input GetCommentsInput) (types.MapOutput, error) {
+	if err := validateFormat(input.Format); err != nil {
+		return nil, err
+	}
+
 	queryParams := url.Values{}
 	client.SetQueryParam(queryParams, "startAt", input.StartAt, 0)
 	client.SetQueryParam(queryParams, "maxResults", input.MaxResults, 0)
@@ -41,20 +45,33 @@ func (c *JiraClient) GetComments(ctx context.Context, input GetCommentsInput) (t
 		return nil, err
 	}
 
+	if input.Format == FormatMarkdown {
+		commentsToMarkdown(output)
+	}
+
 	return output, nil
 }
 
 // AddComment adds a comment to a specific issue.
 //
 // Parameters:
-//   - input: AddCommentInput containing issueKey and comment
+//   - input: AddCommentInput containing issueKey, comment, and format
 //
 // Returns:
 //   - types.MapOutput: The added comment data
 //   - error: An error if the request fails
 func (c *JiraClient) AddComment(ctx context.Context, input AddCommentInput) (types.MapOutput, error) {
+	if err := validateFormat(input.Format); err != nil {
+		return nil, err
+	}
+
+	comment := input.Comment
+	if input.Format == FormatMarkdown {
+		comment = MarkdownToWiki(comment)
+	}
+
 	payload := types.MapOutput{}
-	client.SetRequestBodyParam(payload, "body", input.Comment)
+	client.SetRequestBodyParam(payload, "body", comment)
 
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
@@ -76,5 +93,9 @@ func (c *JiraClient) AddComment(ctx context.Context, input AddCommentInput) (typ
 		return nil, err
 	}
 
+	if input.Format == FormatMarkdown {
+		commentToMarkdown(output)
+	}
+
 	return output, nil
 }
@@ -6,10 +6,12 @@ type GetCommentsInput struct {
 	IssueKey string `json:"issueKey" jsonschema:"required,The key of the issue"`
 	Expand   string `json:"expand,omitempty" jsonschema:"Use expand to include additional information about comments"`
 	OrderBy  string `json:"orderBy,omitempty" jsonschema:"Ordering of comments by creation date"`
+	Format   string `json:"format,omitempty" jsonschema:"The format of the returned comment bodies: wiki (default) or markdown"`
 }
 
 // AddCommentInput represents the input parameters for adding a comment
 type AddCommentInput struct {
 	IssueKey string `json:"issueKey" jsonschema:"required,The key of the issue"`
 	Comment  string `json:"comment" jsonschema:"required,The comment text to add"`
+	Format   string `json:"format,omitempty" jsonschema:"The format of the comment text: wiki (default) or markdown"`
 }
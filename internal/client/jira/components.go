@@ -0,0 +1,78 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// GetProjectComponents retrieves the components of a project.
+//
+// Parameters:
+//   - input: GetProjectComponentsInput containing projectKey
+//
+// Returns:
+//   - []types.MapOutput: The components data
+//   - error: An error if the request fails
+func (c *JiraClient) GetProjectComponents(ctx context.Context, input GetProjectComponentsInput) ([]types.MapOutput, error) {
+	var outputs []any
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "project", input.ProjectKey, "components"},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&outputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapItems(outputs), nil
+}
+
+// CreateComponent creates a component in a project.
+//
+// Parameters:
+//   - input: CreateComponentInput containing projectKey, name, description, leadUserName, and assigneeType
+//
+// Returns:
+//   - types.MapOutput: The created component data
+//   - error: An error if the request fails
+func (c *JiraClient) CreateComponent(ctx context.Context, input CreateComponentInput) (types.MapOutput, error) {
+	payload := types.MapOutput{
+		"project": input.ProjectKey,
+		"name":    input.Name,
+	}
+	client.SetRequestBodyParam(payload, "description", input.Description)
+	client.SetRequestBodyParam(payload, "leadUserName", input.LeadUserName)
+	client.SetRequestBodyParam(payload, "assigneeType", input.AssigneeType)
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	var output types.MapOutput
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPost,
+		[]any{"rest", "api", "2", "component"},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
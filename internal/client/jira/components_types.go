@@ -0,0 +1,15 @@
+package jira
+
+// GetProjectComponentsInput represents the input parameters for getting the components of a project
+type GetProjectComponentsInput struct {
+	ProjectKey string `json:"projectKey" jsonschema:"required,The key of the project"`
+}
+
+// CreateComponentInput represents the input parameters for creating a component
+type CreateComponentInput struct {
+	ProjectKey   string `json:"projectKey" jsonschema:"required,The key of the project to create the component in"`
+	Name         string `json:"name" jsonschema:"required,The name of the component"`
+	Description  string `json:"description,omitempty" jsonschema:"The description of the component"`
+	LeadUserName string `json:"leadUserName,omitempty" jsonschema:"The username of the component lead"`
+	AssigneeType string `json:"assigneeType,omitempty" jsonschema:"The default assignee of issues with the component: PROJECT_DEFAULT, COMPONENT_LEAD, PROJECT_LEAD or UNASSIGNED"`
+}
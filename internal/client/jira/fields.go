@@ -0,0 +1,469 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// fieldRegistryTTL is how long the fields of the instance are cached. Custom
+// fields are rarely added, so a stale registry only delays new fields a little.
+const fieldRegistryTTL = 10 * time.Minute
+
+// createMetaPageSize is the number of entries requested per createmeta page
+const createMetaPageSize = 100
+
+// systemFieldIDPattern matches the IDs of system fields such as summary or
+// fixVersions. Keys that do not match are resolved through the field registry.
+var systemFieldIDPattern = regexp.MustCompile(`^[a-z][a-zA-Z]*$`)
+
+// customFieldIDPattern matches the IDs of custom fields
+var customFieldIDPattern = regexp.MustCompile(`^customfield_\d+$`)
+
+// fieldRegistry caches the fields of the instance to resolve field names to IDs
+type fieldRegistry struct {
+	mu        sync.Mutex
+	fields    []Field
+	expiresAt time.Time
+}
+
+// GetFields retrieves the system and custom fields of the Jira instance.
+// The fields are served from the cached field registry.
+//
+// Parameters:
+//   - input: GetFieldsInput containing customOnly and query
+//
+// Returns:
+//   - []Field: The fields sorted by name
+//   - error: An error if the request fails
+func (c *JiraClient) GetFields(ctx context.Context, input GetFieldsInput) ([]Field, error) {
+	fields, err := c.allFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.ToLower(input.Query)
+	var outputs []Field
+	for _, field := range fields {
+		if input.CustomOnly && !field.Custom {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(field.Name), query) && !strings.Contains(strings.ToLower(field.ID), query) {
+			continue
+		}
+		outputs = append(outputs, field)
+	}
+
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].Name < outputs[j].Name
+	})
+
+	return outputs, nil
+}
+
+// GetCreateMeta retrieves the issue types of a project, or the fields of an issue
+// type with their allowed values when an issue type is given.
+//
+// Parameters:
+//   - input: GetCreateMetaInput containing projectKey, issueType, startAt, and maxResults
+//
+// Returns:
+//   - types.MapOutput: The page of issue types or fields
+//   - error: An error if the request fails
+func (c *JiraClient) GetCreateMeta(ctx context.Context, input GetCreateMetaInput) (types.MapOutput, error) {
+	path := []any{"rest", "api", "2", "issue", "createmeta", input.ProjectKey, "issuetypes"}
+	if input.IssueType != "" {
+		issueTypeID, err := c.issueTypeID(ctx, input.ProjectKey, input.IssueType)
+		if err != nil {
+			return nil, err
+		}
+		path = append(path, issueTypeID)
+	}
+
+	queryParams := url.Values{}
+	client.SetQueryParam(queryParams, "startAt", input.StartAt, 0)
+	client.SetQueryParam(queryParams, "maxResults", input.MaxResults, 0)
+
+	var output types.MapOutput
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		path,
+		queryParams,
+		nil,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// allFields returns the fields of the instance, fetching them when the cached
+// registry has expired.
+func (c *JiraClient) allFields(ctx context.Context) ([]Field, error) {
+	c.fields.mu.Lock()
+	defer c.fields.mu.Unlock()
+
+	if c.fields.fields != nil && time.Now().Before(c.fields.expiresAt) {
+		return c.fields.fields, nil
+	}
+
+	var fields []Field
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "field"},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&fields,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fields: %w", err)
+	}
+
+	c.fields.fields = fields
+	c.fields.expiresAt = time.Now().Add(fieldRegistryTTL)
+	return fields, nil
+}
+
+// resolveFieldID resolves a field ID or a case-insensitive field name to a field ID
+func resolveFieldID(fields []Field, key string) (string, error) {
+	var matches []string
+	for _, field := range fields {
+		if field.ID == key {
+			return field.ID, nil
+		}
+		if strings.EqualFold(field.Name, key) {
+			matches = append(matches, field.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		if systemFieldIDPattern.MatchString(key) || customFieldIDPattern.MatchString(key) {
+			return key, nil
+		}
+		return "", fmt.Errorf("unknown field %q, use jira_get_fields to list the available fields", key)
+	default:
+		return "", fmt.Errorf("field name %q is ambiguous, use one of the field IDs %s", key, strings.Join(matches, ", "))
+	}
+}
+
+// needsFieldResolution reports whether any key is a field name or a custom field
+// ID, which are resolved and validated against the field metadata.
+func needsFieldResolution(values map[string]any) bool {
+	for key := range values {
+		if !systemFieldIDPattern.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveFields replaces field names by field IDs and converts values to the
+// allowed values of the fields. The meta describes the fields of the create or
+// edit screen and is keyed by field ID.
+func (c *JiraClient) resolveFields(ctx context.Context, values map[string]any, meta map[string]fieldMeta) (types.MapOutput, error) {
+	fields, err := c.allFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := types.MapOutput{}
+	for key, value := range values {
+		id, err := resolveFieldID(fields, key)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := output[id]; ok {
+			return nil, fmt.Errorf("field %q is set more than once", id)
+		}
+
+		m, ok := meta[id]
+		if !ok {
+			if customFieldIDPattern.MatchString(id) {
+				return nil, fmt.Errorf("field %q (%s) is not on the screen of this issue", key, id)
+			}
+			output[id] = value
+			continue
+		}
+
+		value, err = allowedValue(m, value)
+		if err != nil {
+			return nil, err
+		}
+		output[id] = value
+	}
+
+	return output, nil
+}
+
+// allowedValue converts names of allowed values to references by ID. Values that
+// are not strings are passed through, so that callers can still send raw objects.
+func allowedValue(m fieldMeta, value any) (any, error) {
+	if len(m.AllowedValues) == 0 {
+		return value, nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		ref, err := matchAllowedValue(m, v)
+		if err != nil {
+			return nil, err
+		}
+		if m.Schema.Type == "array" {
+			return []any{ref}, nil
+		}
+		return ref, nil
+	case []any:
+		values := make([]any, len(v))
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				values[i] = item
+				continue
+			}
+			ref, err := matchAllowedValue(m, s)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = ref
+		}
+		return values, nil
+	default:
+		return value, nil
+	}
+}
+
+// matchAllowedValue finds an allowed value by its ID, value, name or key
+func matchAllowedValue(m fieldMeta, s string) (map[string]any, error) {
+	var labels []string
+	for _, allowed := range m.AllowedValues {
+		for _, key := range []string{"id", "value", "name", "key"} {
+			if v, ok := allowed[key].(string); ok && strings.EqualFold(v, s) {
+				if id, ok := allowed["id"]; ok {
+					return map[string]any{"id": id}, nil
+				}
+				return map[string]any{key: v}, nil
+			}
+		}
+		for _, key := range []string{"value", "name"} {
+			if v, ok := allowed[key].(string); ok {
+				labels = append(labels, v)
+				break
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("invalid value %q for field %q, allowed values are: %s", s, m.Name, strings.Join(labels, ", "))
+}
+
+// issueTypeID resolves an issue type name or ID to the ID of an issue type of a project
+func (c *JiraClient) issueTypeID(ctx context.Context, projectKey, issueType string) (string, error) {
+	var names []string
+	for startAt := 0; ; {
+		queryParams := url.Values{}
+		queryParams.Set("startAt", fmt.Sprint(startAt))
+		queryParams.Set("maxResults", fmt.Sprint(createMetaPageSize))
+
+		var output struct {
+			IsLast bool `json:"isLast"`
+			Values []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"values"`
+		}
+		err := client.ExecuteRequest(
+			ctx,
+			c.BaseClient,
+			http.MethodGet,
+			[]any{"rest", "api", "2", "issue", "createmeta", projectKey, "issuetypes"},
+			queryParams,
+			nil,
+			client.AcceptJSON,
+			&output,
+		)
+		if err != nil {
+			return "", fmt.Errorf("failed to get issue types of %s: %w", projectKey, err)
+		}
+
+		for _, value := range output.Values {
+			if value.ID == issueType || strings.EqualFold(value.Name, issueType) {
+				return value.ID, nil
+			}
+			names = append(names, value.Name)
+		}
+
+		if output.IsLast || len(output.Values) == 0 {
+			break
+		}
+		startAt += len(output.Values)
+	}
+
+	return "", fmt.Errorf("unknown issue type %q in project %s, available issue types are: %s", issueType, projectKey, strings.Join(names, ", "))
+}
+
+// createFieldMeta returns the fields of the create screen of an issue type, keyed by field ID
+func (c *JiraClient) createFieldMeta(ctx context.Context, projectKey, issueTypeID string) (map[string]fieldMeta, error) {
+	meta := map[string]fieldMeta{}
+	for startAt := 0; ; {
+		queryParams := url.Values{}
+		queryParams.Set("startAt", fmt.Sprint(startAt))
+		queryParams.Set("maxResults", fmt.Sprint(createMetaPageSize))
+
+		var output struct {
+			IsLast bool        `json:"isLast"`
+			Values []fieldMeta `json:"values"`
+		}
+		err := client.ExecuteRequest(
+			ctx,
+			c.BaseClient,
+			http.MethodGet,
+			[]any{"rest", "api", "2", "issue", "createmeta", projectKey, "issuetypes", issueTypeID},
+			queryParams,
+			nil,
+			client.AcceptJSON,
+			&output,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get create metadata of %s: %w", projectKey, err)
+		}
+
+		for _, value := range output.Values {
+			meta[value.FieldID] = value
+		}
+
+		if output.IsLast || len(output.Values) == 0 {
+			return meta, nil
+		}
+		startAt += len(output.Values)
+	}
+}
+
+// editFieldMeta returns the fields of the edit screen of an issue, keyed by field ID
+func (c *JiraClient) editFieldMeta(ctx context.Context, issueKey string) (map[string]fieldMeta, error) {
+	var output struct {
+		Fields map[string]fieldMeta `json:"fields"`
+	}
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "issue", issueKey, "editmeta"},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get edit metadata of %s: %w", issueKey, err)
+	}
+
+	for id, m := range output.Fields {
+		m.FieldID = id
+		output.Fields[id] = m
+	}
+	return output.Fields, nil
+}
+
+// resolveFieldList replaces field names in a list of fields to return by field IDs
+func (c *JiraClient) resolveFieldList(ctx context.Context, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return names, nil
+	}
+
+	fields, err := c.allFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(names))
+	for i, name := range names {
+		if strings.HasPrefix(name, "-") || name == "*all" || name == "*navigable" {
+			ids[i] = name
+			continue
+		}
+		id, err := resolveFieldID(fields, name)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// decodeNamedCustomFields decodes an issue or a search result and renames the
+// custom fields of the issues to their names before the response is pruned, so
+// that the customfield prune rule does not remove them.
+func (c *JiraClient) decodeNamedCustomFields(ctx context.Context, body json.RawMessage) (types.MapOutput, error) {
+	var output types.MapOutput
+	if err := json.Unmarshal(body, &output); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	fields, err := c.allFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(fields))
+	for _, field := range fields {
+		if field.Custom {
+			names[field.ID] = field.Name
+		}
+	}
+
+	nameCustomFields(output, names)
+	if issues, ok := output["issues"].([]any); ok {
+		for _, issue := range issues {
+			if issue, ok := issue.(map[string]any); ok {
+				nameCustomFields(issue, names)
+			}
+		}
+	}
+
+	client.Prune(ctx, c.Name, &output)
+	return output, nil
+}
+
+// nameCustomFields renames the custom fields of an issue to their names. Fields
+// whose name is already taken keep their ID.
+func nameCustomFields(issue map[string]any, names map[string]string) {
+	fields, ok := issue["fields"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for id, value := range fields {
+		name, ok := names[id]
+		if !ok {
+			continue
+		}
+		if value == nil {
+			delete(fields, id)
+			continue
+		}
+		if _, taken := fields[name]; taken {
+			continue
+		}
+		fields[name] = value
+		delete(fields, id)
+	}
+}
@@ -0,0 +1,188 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testFields = `[
+	{"id":"summary","name":"Summary","custom":false,"schema":{"type":"string","system":"summary"}},
+	{"id":"components","name":"Component/s","custom":false,"schema":{"type":"array","items":"component","system":"components"}},
+	{"id":"customfield_10002","name":"Story Points","custom":true,"schema":{"type":"number"}},
+	{"id":"customfield_10003","name":"Team","custom":true,"schema":{"type":"option"}},
+	{"id":"customfield_10004","name":"Duplicate","custom":true,"schema":{"type":"string"}},
+	{"id":"customfield_10005","name":"Duplicate","custom":true,"schema":{"type":"string"}}
+]`
+
+const testCreateMeta = `{"isLast":true,"values":[
+	{"fieldId":"summary","name":"Summary","required":true,"schema":{"type":"string"}},
+	{"fieldId":"components","name":"Component/s","schema":{"type":"array","items":"component"},"allowedValues":[{"id":"20","name":"API"},{"id":"21","name":"UI"}]},
+	{"fieldId":"customfield_10002","name":"Story Points","schema":{"type":"number"}},
+	{"fieldId":"customfield_10003","name":"Team","schema":{"type":"option"},"allowedValues":[{"id":"30","value":"Backend"},{"id":"31","value":"Frontend"}]}
+]}`
+
+// newFieldsTestClient serves the fields and the create and edit metadata, and
+// passes other requests to handler
+func newFieldsTestClient(t *testing.T, fieldRequests *int, handler http.HandlerFunc) *JiraClient {
+	t.Helper()
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/field":
+			*fieldRequests++
+			w.Write([]byte(testFields))
+		case "/rest/api/2/issue/createmeta/ABC/issuetypes":
+			w.Write([]byte(`{"isLast":true,"values":[{"id":"1","name":"Bug"},{"id":"3","name":"Task"}]}`))
+		case "/rest/api/2/issue/createmeta/ABC/issuetypes/3":
+			w.Write([]byte(testCreateMeta))
+		case "/rest/api/2/issue/ABC-1/editmeta":
+			var meta struct {
+				Values []fieldMeta `json:"values"`
+			}
+			json.Unmarshal([]byte(testCreateMeta), &meta)
+			fields := map[string]fieldMeta{}
+			for _, m := range meta.Values {
+				fields[m.FieldID] = m
+			}
+			json.NewEncoder(w).Encode(map[string]any{"fields": fields})
+		default:
+			handler(w, r)
+		}
+	})
+}
+
+func TestCreateIssueWithNamedFields(t *testing.T) {
+	var fieldRequests int
+	var payload struct {
+		Fields map[string]any `json:"fields"`
+	}
+	c := newFieldsTestClient(t, &fieldRequests, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/api/2/issue" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"key":"ABC-1"}`))
+	})
+
+	input := CreateIssueInput{
+		ProjectKey: "ABC",
+		Summary:    "Summary",
+		IssueType:  "task",
+		Fields: map[string]any{
+			"story points": 5,
+			"Team":         "backend",
+			"components":   "API",
+		},
+	}
+	if _, err := c.CreateIssue(context.Background(), input); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+
+	got, _ := json.Marshal(payload.Fields)
+	for _, want := range []string{`"customfield_10002":5`, `"customfield_10003":{"id":"30"}`, `"components":[{"id":"20"}]`, `"summary":"Summary"`} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("fields = %s, want %s", got, want)
+		}
+	}
+
+	// The field registry is cached
+	if _, err := c.CreateIssue(context.Background(), input); err != nil {
+		t.Fatalf("CreateIssue failed: %v", err)
+	}
+	if fieldRequests != 1 {
+		t.Errorf("fields requested %d times, want 1", fieldRequests)
+	}
+}
+
+func TestCreateIssueWithInvalidFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields map[string]any
+		want   string
+	}{
+		{name: "disallowed value", fields: map[string]any{"Team": "Marketing"}, want: "allowed values are: Backend, Frontend"},
+		{name: "unknown field", fields: map[string]any{"Velocity Points": 3}, want: "unknown field"},
+		{name: "ambiguous field", fields: map[string]any{"Duplicate": "x"}, want: "customfield_10004, customfield_10005"},
+		{name: "field not on screen", fields: map[string]any{"customfield_10004": "x"}, want: "not on the screen"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fieldRequests int
+			c := newFieldsTestClient(t, &fieldRequests, func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			})
+
+			_, err := c.CreateIssue(context.Background(), CreateIssueInput{ProjectKey: "ABC", Summary: "Summary", IssueType: "Task", Fields: tt.fields})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("CreateIssue error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateIssueWithNamedFields(t *testing.T) {
+	var fieldRequests int
+	var payload struct {
+		Fields map[string]any `json:"fields"`
+	}
+	c := newFieldsTestClient(t, &fieldRequests, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/rest/api/2/issue/ABC-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	// System field IDs are sent as they are without looking up the fields
+	if _, err := c.UpdateIssue(context.Background(), UpdateIssueInput{IssueKey: "ABC-1", Updates: map[string]any{"summary": "New"}}); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+	if fieldRequests != 0 {
+		t.Errorf("fields requested %d times, want 0", fieldRequests)
+	}
+
+	payload.Fields = nil
+	if _, err := c.UpdateIssue(context.Background(), UpdateIssueInput{IssueKey: "ABC-1", Updates: map[string]any{"Team": "Frontend", "Story Points": 3}}); err != nil {
+		t.Fatalf("UpdateIssue failed: %v", err)
+	}
+	got, _ := json.Marshal(payload.Fields)
+	if want := `{"customfield_10002":3,"customfield_10003":{"id":"31"}}`; string(got) != want {
+		t.Errorf("fields = %s, want %s", got, want)
+	}
+}
+
+func TestGetIssueWithNamedCustomFields(t *testing.T) {
+	var fieldRequests int
+	c := newFieldsTestClient(t, &fieldRequests, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/2/issue/ABC-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			return
+		}
+		if got := r.URL.Query().Get("fields"); r.URL.Query().Has("fields") && got != "summary,customfield_10002" {
+			t.Errorf("fields = %q, want summary,customfield_10002", got)
+		}
+		w.Write([]byte(`{"key":"ABC-1","fields":{"summary":"Summary","customfield_10002":5,"customfield_10003":null}}`))
+	})
+
+	issue, err := c.GetIssue(context.Background(), GetIssueInput{IssueKey: "ABC-1"})
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	if fields := issue["fields"].(map[string]any); len(fields) != 1 {
+		t.Errorf("fields = %v, want only the summary", fields)
+	}
+
+	issue, err = c.GetIssue(context.Background(), GetIssueInput{IssueKey: "ABC-1", Fields: []string{"summary", "Story Points"}, NamedCustomFields: true})
+	if err != nil {
+		t.Fatalf("GetIssue failed: %v", err)
+	}
+	fields := issue["fields"].(map[string]any)
+	if fields["Story Points"] != float64(5) || len(fields) != 2 {
+		t.Errorf("fields = %v, want the summary and Story Points", fields)
+	}
+}
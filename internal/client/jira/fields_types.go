@@ -0,0 +1,40 @@
+package jira
+
+// GetFieldsInput represents the input parameters for getting the fields of the Jira instance
+type GetFieldsInput struct {
+	CustomOnly bool   `json:"customOnly,omitempty" jsonschema:"Whether to return only custom fields"`
+	Query      string `json:"query,omitempty" jsonschema:"Only return fields whose name or ID contains this text (case-insensitive)"`
+}
+
+// GetCreateMetaInput represents the input parameters for getting the create metadata of a project
+type GetCreateMetaInput struct {
+	PaginationInput
+	ProjectKey string `json:"projectKey" jsonschema:"required,The key or ID of the project"`
+	IssueType  string `json:"issueType,omitempty" jsonschema:"The name or ID of the issue type. When empty the issue types of the project are returned instead of their fields"`
+}
+
+// Field describes a system or custom field of the Jira instance
+type Field struct {
+	ID     string      `json:"id"`
+	Name   string      `json:"name"`
+	Custom bool        `json:"custom"`
+	Schema FieldSchema `json:"schema"`
+}
+
+// FieldSchema describes the type of the values of a field
+type FieldSchema struct {
+	Type     string `json:"type,omitempty"`
+	Items    string `json:"items,omitempty"`
+	System   string `json:"system,omitempty"`
+	Custom   string `json:"custom,omitempty"`
+	CustomID int64  `json:"customId,omitempty"`
+}
+
+// fieldMeta describes a field on a create or edit screen
+type fieldMeta struct {
+	FieldID       string           `json:"fieldId"`
+	Name          string           `json:"name"`
+	Required      bool             `json:"required"`
+	Schema        FieldSchema      `json:"schema"`
+	AllowedValues []map[string]any `json:"allowedValues"`
+}
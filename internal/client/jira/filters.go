@@ -0,0 +1,166 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// GetFavouriteFilters retrieves the favourite filters of the current user.
+//
+// Returns:
+//   - []types.MapOutput: The filters with their JQL
+//   - error: An error if the request fails
+func (c *JiraClient) GetFavouriteFilters(ctx context.Context) ([]types.MapOutput, error) {
+	var outputs []any
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "filter", "favourite"},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&outputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapItems(outputs), nil
+}
+
+// GetFilter retrieves a saved filter by its ID.
+//
+// Parameters:
+//   - input: GetFilterInput containing filterId
+//
+// Returns:
+//   - types.MapOutput: The filter with its JQL
+//   - error: An error if the request fails
+func (c *JiraClient) GetFilter(ctx context.Context, input GetFilterInput) (types.MapOutput, error) {
+	var output types.MapOutput
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "filter", input.FilterId},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// SearchByFilter searches for issues with the JQL of a saved filter. The filter is
+// given by ID or by the name of one of the favourite filters of the current user.
+//
+// Parameters:
+//   - input: SearchByFilterInput containing filter, fields, format, namedCustomFields, startAt, and maxResults
+//
+// Returns:
+//   - types.MapOutput: The search results with the filter that was used
+//   - error: An error if the request fails
+func (c *JiraClient) SearchByFilter(ctx context.Context, input SearchByFilterInput) (types.MapOutput, error) {
+	filter, err := c.resolveFilter(ctx, input.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	jql, ok := filter["jql"].(string)
+	if !ok || jql == "" {
+		return nil, fmt.Errorf("filter %q has no JQL", input.Filter)
+	}
+
+	output, err := c.SearchIssues(ctx, SearchIssuesInput{
+		PaginationInput:   input.PaginationInput,
+		JQL:               jql,
+		Fields:            input.Fields,
+		Format:            input.Format,
+		NamedCustomFields: input.NamedCustomFields,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output["filter"] = types.MapOutput{
+		"id":   filter["id"],
+		"name": filter["name"],
+		"jql":  jql,
+	}
+	return output, nil
+}
+
+// CreateFilter creates a saved filter.
+//
+// Parameters:
+//   - input: CreateFilterInput containing name, jql, description, and favourite
+//
+// Returns:
+//   - types.MapOutput: The created filter
+//   - error: An error if the request fails
+func (c *JiraClient) CreateFilter(ctx context.Context, input CreateFilterInput) (types.MapOutput, error) {
+	payload := types.MapOutput{
+		"name": input.Name,
+		"jql":  input.JQL,
+	}
+	client.SetRequestBodyParam(payload, "description", input.Description)
+	client.SetRequestBodyParam(payload, "favourite", input.Favourite)
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	var output types.MapOutput
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPost,
+		[]any{"rest", "api", "2", "filter"},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// resolveFilter returns the filter with an ID, or the favourite filter with a
+// name. Jira Data Center can only search the favourite filters by name.
+func (c *JiraClient) resolveFilter(ctx context.Context, filter string) (types.MapOutput, error) {
+	if _, err := strconv.ParseInt(filter, 10, 64); err == nil {
+		return c.GetFilter(ctx, GetFilterInput{FilterId: filter})
+	}
+
+	filters, err := c.GetFavouriteFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get favourite filters: %w", err)
+	}
+
+	names := make([]string, 0, len(filters))
+	for _, f := range filters {
+		name, _ := f["name"].(string)
+		if strings.EqualFold(name, filter) {
+			return f, nil
+		}
+		names = append(names, name)
+	}
+
+	return nil, fmt.Errorf("no favourite filter is named %q, use the ID of the filter or one of: %s", filter, strings.Join(names, ", "))
+}
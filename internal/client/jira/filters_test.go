@@ -0,0 +1,60 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSearchByFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		wantJQL string
+		wantErr string
+	}{
+		{name: "by ID", filter: "10000", wantJQL: "project = ABC"},
+		{name: "by favourite name", filter: "my open bugs", wantJQL: "assignee = currentUser() AND type = Bug"},
+		{name: "unknown name", filter: "Other", wantErr: "My open bugs, Sprint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var jql string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/rest/api/2/filter/10000":
+					w.Write([]byte(`{"id":"10000","name":"Project","jql":"project = ABC"}`))
+				case "/rest/api/2/filter/favourite":
+					w.Write([]byte(`[{"id":"10001","name":"My open bugs","jql":"assignee = currentUser() AND type = Bug"},{"id":"10002","name":"Sprint","jql":"sprint in openSprints()"}]`))
+				case "/rest/api/2/search":
+					var payload map[string]any
+					json.NewDecoder(r.Body).Decode(&payload)
+					jql, _ = payload["jql"].(string)
+					w.Write([]byte(`{"total":1,"issues":[{"key":"ABC-1"}]}`))
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			output, err := c.SearchByFilter(context.Background(), SearchByFilterInput{Filter: tt.filter})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("SearchByFilter error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SearchByFilter failed: %v", err)
+			}
+			if jql != tt.wantJQL {
+				t.Errorf("jql = %q, want %q", jql, tt.wantJQL)
+			}
+			if filter, ok := output["filter"].(map[string]any); !ok || filter["jql"] != tt.wantJQL {
+				t.Errorf("filter = %v, want the filter with its JQL", output["filter"])
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package jira
+
+// GetFilterInput represents the input parameters for getting a saved filter
+type GetFilterInput struct {
+	FilterId string `json:"filterId" jsonschema:"required,The ID of the filter"`
+}
+
+// SearchByFilterInput represents the input parameters for searching issues with a saved filter
+type SearchByFilterInput struct {
+	PaginationInput
+	Filter            string   `json:"filter" jsonschema:"required,The ID of the filter, or the name of one of your favourite filters"`
+	Fields            []string `json:"fields,omitempty" jsonschema:"The list of fields to return for each issue. Field names are accepted when namedCustomFields is set"`
+	Format            string   `json:"format,omitempty" jsonschema:"The format of the returned descriptions, environments and comment bodies: wiki (default) or markdown"`
+	NamedCustomFields bool     `json:"namedCustomFields,omitempty" jsonschema:"Whether to return custom fields under their names instead of removing them from the response"`
+}
+
+// CreateFilterInput represents the input parameters for creating a saved filter
+type CreateFilterInput struct {
+	Name        string `json:"name" jsonschema:"required,The name of the filter"`
+	JQL         string `json:"jql" jsonschema:"required,The JQL query of the filter"`
+	Description string `json:"description,omitempty" jsonschema:"The description of the filter"`
+	Favourite   bool   `json:"favourite,omitempty" jsonschema:"Whether to add the filter to your favourite filters"`
+}
@@ -15,17 +15,36 @@ import (
 // GetIssue retrieves a specific issue by its key.
 //
 // Parameters:
-//   - input: GetIssueInput containing issueKey and fields
+//   - input: GetIssueInput containing issueKey, fields, format, and namedCustomFields
 //
 // Returns:
 //   - types.MapOutput: The issue data
 //   - error: An error if the request fails
 func (c *JiraClient) GetIssue(ctx context.Context, input GetIssueInput) (types.MapOutput, error) {
+	if err := validateFormat(input.Format); err != nil {
+		return nil, err
+	}
+
+	fields := input.Fields
+	if input.NamedCustomFields {
+		var err error
+		if fields, err = c.resolveFieldList(ctx, fields); err != nil {
+			return nil, err
+		}
+	}
+
 	queryParams := url.Values{}
 	// Pass nil as the invalid value for fields since we want to include fields when the slice is empty
-	client.SetQueryParam(queryParams, "fields", input.Fields, nil)
+	client.SetQueryParam(queryParams, "fields", fields, nil)
 
+	// Decoding into a raw message skips pruning, so that custom fields can be
+	// renamed before they are pruned
 	var output types.MapOutput
+	var raw json.RawMessage
+	result := any(&output)
+	if input.NamedCustomFields {
+		result = &raw
+	}
 	err := client.ExecuteRequest(
 		ctx,
 		c.BaseClient,
@@ -34,42 +53,77 @@ func (c *JiraClient) GetIssue(ctx context.Context, input GetIssueInput) (types.M
 		queryParams,
 		nil,
 		client.AcceptJSON,
-		&output,
+		result,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if input.NamedCustomFields {
+		if output, err = c.decodeNamedCustomFields(ctx, raw); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Format == FormatMarkdown {
+		issueToMarkdown(output)
+	}
+
 	return output, nil
 }
 
 // CreateIssue creates a new issue.
 //
 // Parameters:
-//   - input: CreateIssueInput containing projectKey, summary, issueType, description, and priority
+//   - input: CreateIssueInput containing projectKey, summary, issueType, description, priority, format, and fields
 //
 // Returns:
 //   - types.MapOutput: The created issue data
 //   - error: An error if the request fails
 func (c *JiraClient) CreateIssue(ctx context.Context, input CreateIssueInput) (types.MapOutput, error) {
-	payload := types.MapOutput{
-		"fields": types.MapOutput{
-			"project": map[string]string{
-				"key": input.ProjectKey,
-			},
-			"summary": input.Summary,
-			"issuetype": map[string]string{
-				"name": input.IssueType,
-			},
-			"description": input.Description,
-			"priority": map[string]string{
-				"name": input.Priority,
-			},
+	if err := validateFormat(input.Format); err != nil {
+		return nil, err
+	}
+
+	description := input.Description
+	if input.Format == FormatMarkdown {
+		description = MarkdownToWiki(description)
+	}
+
+	fields := types.MapOutput{
+		"project": map[string]string{
+			"key": input.ProjectKey,
+		},
+		"summary": input.Summary,
+		"issuetype": map[string]string{
+			"name": input.IssueType,
 		},
+		"description": description,
+		"priority": map[string]string{
+			"name": input.Priority,
+		},
+	}
+
+	if len(input.Fields) > 0 {
+		issueTypeID, err := c.issueTypeID(ctx, input.ProjectKey, input.IssueType)
+		if err != nil {
+			return nil, err
+		}
+		meta, err := c.createFieldMeta(ctx, input.ProjectKey, issueTypeID)
+		if err != nil {
+			return nil, err
+		}
+		extra, err := c.resolveFields(ctx, input.Fields, meta)
+		if err != nil {
+			return nil, err
+		}
+		for id, value := range extra {
+			fields[id] = value
+		}
 	}
 
 	createPayloadInput := CreateIssueWithPayloadInput{
-		Payload:       payload,
+		Payload:       types.MapOutput{"fields": fields},
 		UpdateHistory: false,
 	}
 
@@ -116,12 +170,21 @@ func (c *JiraClient) CreateIssueWithPayload(ctx context.Context, input CreateIss
 // CreateSubTask creates a new sub-task for an issue.
 //
 // Parameters:
-//   - input: CreateSubTaskInput containing parentKeyOrID, projectKey, summary, issueType, description, and priority
+//   - input: CreateSubTaskInput containing parentKeyOrID, projectKey, summary, issueType, description, priority, and format
 //
 // Returns:
 //   - types.MapOutput: The created sub-task data
 //   - error: An error if the request fails
 func (c *JiraClient) CreateSubTask(ctx context.Context, input CreateSubTaskInput) (types.MapOutput, error) {
+	if err := validateFormat(input.Format); err != nil {
+		return nil, err
+	}
+
+	description := input.Description
+	if input.Format == FormatMarkdown {
+		description = MarkdownToWiki(description)
+	}
+
 	payload := types.MapOutput{
 		"fields": types.MapOutput{
 			"project": map[string]string{
@@ -131,7 +194,7 @@ func (c *JiraClient) CreateSubTask(ctx context.Context, input CreateSubTaskInput
 			"issuetype": map[string]string{
 				"name": input.IssueType,
 			},
-			"description": input.Description,
+			"description": description,
 			"priority": map[string]string{
 				"name": input.Priority,
 			},
@@ -152,7 +215,7 @@ func (c *JiraClient) CreateSubTask(ctx context.Context, input CreateSubTaskInput
 // UpdateIssue updates an existing issue.
 //
 // Parameters:
-//   - input: UpdateIssueInput containing issueKey and updates
+//   - input: UpdateIssueInput containing issueKey, updates, and format
 //
 // Returns:
 //   - types.MapOutput: The updated issue data
@@ -162,23 +225,40 @@ func (c *JiraClient) UpdateIssue(ctx context.Context, input UpdateIssueInput) (t
 		IssueKey: input.IssueKey,
 		Updates:  input.Updates,
 		Options:  nil,
+		Format:   input.Format,
 	})
 }
 
 // UpdateIssueWithOptions updates an existing issue with additional options.
 //
 // Parameters:
-//   - input: UpdateIssueWithOptionsInput containing issueKey, updates, and options
+//   - input: UpdateIssueWithOptionsInput containing issueKey, updates, options, and format
 //
 // Returns:
 //   - types.MapOutput: The updated issue data
 //   - error: An error if the request fails
 func (c *JiraClient) UpdateIssueWithOptions(ctx context.Context, input UpdateIssueWithOptionsInput) (types.MapOutput, error) {
+	if err := validateFormat(input.Format); err != nil {
+		return nil, err
+	}
 
 	payload := types.MapOutput{}
 
 	if input.Updates != nil {
-		payload["fields"] = input.Updates
+		updates := input.Updates
+		if needsFieldResolution(updates) {
+			meta, err := c.editFieldMeta(ctx, input.IssueKey)
+			if err != nil {
+				return nil, err
+			}
+			if updates, err = c.resolveFields(ctx, updates, meta); err != nil {
+				return nil, err
+			}
+		}
+		if input.Format == FormatMarkdown {
+			updates = fieldsToWiki(updates)
+		}
+		payload["fields"] = updates
 	}
 
 	jsonPayload, err := json.Marshal(payload)
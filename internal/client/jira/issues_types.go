@@ -4,17 +4,21 @@ import "atlassian-dc-mcp-go/internal/types"
 
 // GetIssueInput represents the input parameters for getting an issue
 type GetIssueInput struct {
-	IssueKey string   `json:"issueKey" jsonschema:"required,The key of the issue to retrieve"`
-	Fields   []string `json:"fields,omitempty" jsonschema:"The list of fields to return for the issue"`
+	IssueKey          string   `json:"issueKey" jsonschema:"required,The key of the issue to retrieve"`
+	Fields            []string `json:"fields,omitempty" jsonschema:"The list of fields to return for the issue. Field names are accepted when namedCustomFields is set"`
+	Format            string   `json:"format,omitempty" jsonschema:"The format of the returned description, environment and comment bodies: wiki (default) or markdown"`
+	NamedCustomFields bool     `json:"namedCustomFields,omitempty" jsonschema:"Whether to return custom fields under their names instead of removing them from the response"`
 }
 
 // CreateIssueInput represents the input parameters for creating an issue
 type CreateIssueInput struct {
-	ProjectKey  string `json:"projectKey" jsonschema:"required,The key of the project to create the issue in"`
-	Summary     string `json:"summary" jsonschema:"required,The summary of the issue"`
-	IssueType   string `json:"issueType" jsonschema:"required,The type of the issue"`
-	Description string `json:"description,omitempty" jsonschema:"The description of the issue"`
-	Priority    string `json:"priority,omitempty" jsonschema:"The priority of the issue"`
+	ProjectKey  string          `json:"projectKey" jsonschema:"required,The key of the project to create the issue in"`
+	Summary     string          `json:"summary" jsonschema:"required,The summary of the issue"`
+	IssueType   string          `json:"issueType" jsonschema:"required,The type of the issue"`
+	Description string          `json:"description,omitempty" jsonschema:"The description of the issue"`
+	Priority    string          `json:"priority,omitempty" jsonschema:"The priority of the issue"`
+	Format      string          `json:"format,omitempty" jsonschema:"The format of the description: wiki (default) or markdown"`
+	Fields      types.MapOutput `json:"fields,omitempty" jsonschema:"Additional fields keyed by field name or ID, such as Story Points or customfield_10010. Names of allowed values are converted to their IDs"`
 }
 
 // CreateIssueWithPayloadInput represents the input parameters for creating an issue with a custom payload
@@ -31,19 +35,22 @@ type CreateSubTaskInput struct {
 	IssueType     string `json:"issueType" jsonschema:"required,The type of the sub-task"`
 	Description   string `json:"description,omitempty" jsonschema:"The description of the sub-task"`
 	Priority      string `json:"priority,omitempty" jsonschema:"The priority of the sub-task"`
+	Format        string `json:"format,omitempty" jsonschema:"The format of the description: wiki (default) or markdown"`
 }
 
 // UpdateIssueInput represents the input parameters for updating an issue
 type UpdateIssueInput struct {
 	IssueKey string          `json:"issueKey" jsonschema:"required,The key of the issue to update"`
-	Updates  types.MapOutput `json:"updates" jsonschema:"required,The fields to update"`
+	Updates  types.MapOutput `json:"updates" jsonschema:"required,The fields to update keyed by field name or ID. Names of allowed values are converted to their IDs"`
+	Format   string          `json:"format,omitempty" jsonschema:"The format of the description and environment fields: wiki (default) or markdown"`
 }
 
 // UpdateIssueWithOptionsInput represents the input parameters for updating an issue with additional options
 type UpdateIssueWithOptionsInput struct {
 	IssueKey string            `json:"issueKey" jsonschema:"required,The key of the issue to update"`
-	Updates  types.MapOutput   `json:"updates" jsonschema:"required,The fields to update"`
+	Updates  types.MapOutput   `json:"updates" jsonschema:"required,The fields to update keyed by field name or ID. Names of allowed values are converted to their IDs"`
 	Options  map[string]string `json:"options,omitempty" jsonschema:"Additional options for the update"`
+	Format   string            `json:"format,omitempty" jsonschema:"The format of the description and environment fields: wiki (default) or markdown"`
 }
 
 // GetTransitionsInput represents the input parameters for getting transitions available for an issue
@@ -0,0 +1,190 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// GetIssueLinkTypes retrieves the issue link types.
+//
+// Returns:
+//   - types.MapOutput: The issue link types data
+//   - error: An error if the request fails
+func (c *JiraClient) GetIssueLinkTypes(ctx context.Context) (types.MapOutput, error) {
+	var output types.MapOutput
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "issueLinkType"},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// LinkIssues creates a link between two issues.
+//
+// Parameters:
+//   - input: LinkIssuesInput containing linkType, inwardIssueKey, outwardIssueKey, and comment
+//
+// Returns:
+//   - error: An error if the request fails
+func (c *JiraClient) LinkIssues(ctx context.Context, input LinkIssuesInput) error {
+	payload := types.MapOutput{
+		"type": map[string]string{
+			"name": input.LinkType,
+		},
+		"inwardIssue": map[string]string{
+			"key": input.InwardIssueKey,
+		},
+		"outwardIssue": map[string]string{
+			"key": input.OutwardIssueKey,
+		},
+	}
+	if input.Comment != "" {
+		payload["comment"] = map[string]string{
+			"body": input.Comment,
+		}
+	}
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	return client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPost,
+		[]any{"rest", "api", "2", "issueLink"},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		nil,
+	)
+}
+
+// DeleteIssueLink deletes an issue link.
+//
+// Parameters:
+//   - input: DeleteIssueLinkInput containing linkId
+//
+// Returns:
+//   - error: An error if the request fails
+func (c *JiraClient) DeleteIssueLink(ctx context.Context, input DeleteIssueLinkInput) error {
+	return client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodDelete,
+		[]any{"rest", "api", "2", "issueLink", input.LinkId},
+		nil,
+		nil,
+		client.AcceptJSON,
+		nil,
+	)
+}
+
+// GetRemoteLinks retrieves the remote links of an issue.
+//
+// Parameters:
+//   - input: GetRemoteLinksInput containing issueKey and globalId
+//
+// Returns:
+//   - []types.MapOutput: The remote links data
+//   - error: An error if the request fails
+func (c *JiraClient) GetRemoteLinks(ctx context.Context, input GetRemoteLinksInput) ([]types.MapOutput, error) {
+	queryParams := url.Values{}
+	client.SetQueryParam(queryParams, "globalId", input.GlobalId, "")
+
+	// A global ID selects a single remote link, which isn't wrapped in an array
+	if input.GlobalId != "" {
+		var output types.MapOutput
+		err := client.ExecuteRequest(
+			ctx,
+			c.BaseClient,
+			http.MethodGet,
+			[]any{"rest", "api", "2", "issue", input.IssueKey, "remotelink"},
+			queryParams,
+			nil,
+			client.AcceptJSON,
+			&output,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return []types.MapOutput{output}, nil
+	}
+
+	var outputs []types.MapOutput
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "issue", input.IssueKey, "remotelink"},
+		queryParams,
+		nil,
+		client.AcceptJSON,
+		&outputs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
+
+// AddRemoteLink adds a remote link to an issue, or updates the remote link with the same global ID.
+//
+// Parameters:
+//   - input: AddRemoteLinkInput containing issueKey, url, title, summary, relationship, and globalId
+//
+// Returns:
+//   - types.MapOutput: The ID and URL of the remote link
+//   - error: An error if the request fails
+func (c *JiraClient) AddRemoteLink(ctx context.Context, input AddRemoteLinkInput) (types.MapOutput, error) {
+	object := types.MapOutput{}
+	client.SetRequestBodyParam(object, "url", input.URL)
+	client.SetRequestBodyParam(object, "title", input.Title)
+	client.SetRequestBodyParam(object, "summary", input.Summary)
+
+	payload := types.MapOutput{
+		"object": object,
+	}
+	client.SetRequestBodyParam(payload, "globalId", input.GlobalId)
+	client.SetRequestBodyParam(payload, "relationship", input.Relationship)
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	var output types.MapOutput
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPost,
+		[]any{"rest", "api", "2", "issue", input.IssueKey, "remotelink"},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,30 @@
+package jira
+
+// LinkIssuesInput represents the input parameters for linking two issues
+type LinkIssuesInput struct {
+	LinkType        string `json:"linkType" jsonschema:"required,The name of the link type, such as Blocks or Relates"`
+	InwardIssueKey  string `json:"inwardIssueKey" jsonschema:"required,The key of the issue on the inward side of the link. The outward description of the link type applies to it, so for a Blocks link this issue blocks the outward issue"`
+	OutwardIssueKey string `json:"outwardIssueKey" jsonschema:"required,The key of the issue on the outward side of the link. The inward description of the link type applies to it, so for a Blocks link this issue is blocked by the inward issue"`
+	Comment         string `json:"comment,omitempty" jsonschema:"A comment to add to the outward issue"`
+}
+
+// DeleteIssueLinkInput represents the input parameters for deleting an issue link
+type DeleteIssueLinkInput struct {
+	LinkId string `json:"linkId" jsonschema:"required,The ID of the issue link, as found in the issuelinks field of an issue"`
+}
+
+// GetRemoteLinksInput represents the input parameters for getting the remote links of an issue
+type GetRemoteLinksInput struct {
+	IssueKey string `json:"issueKey" jsonschema:"required,The key of the issue"`
+	GlobalId string `json:"globalId,omitempty" jsonschema:"Return only the remote link with this global ID"`
+}
+
+// AddRemoteLinkInput represents the input parameters for adding a remote link to an issue
+type AddRemoteLinkInput struct {
+	IssueKey     string `json:"issueKey" jsonschema:"required,The key of the issue"`
+	URL          string `json:"url" jsonschema:"required,The URL of the linked resource"`
+	Title        string `json:"title" jsonschema:"required,The title of the link"`
+	Summary      string `json:"summary,omitempty" jsonschema:"A summary of the linked resource"`
+	Relationship string `json:"relationship,omitempty" jsonschema:"The relationship between the issue and the linked resource, such as 'mentioned in'"`
+	GlobalId     string `json:"globalId,omitempty" jsonschema:"An ID that identifies the linked resource. Adding a link with an existing global ID updates that link"`
+}
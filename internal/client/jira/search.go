@@ -13,12 +13,15 @@ import (
 // SearchIssues searches for issues using JQL.
 //
 // Parameters:
-//   - input: SearchIssuesInput containing jql, projectKeyOrId, orderBy, statuses, maxResults, startAt, and fields
+//   - input: SearchIssuesInput containing jql, projectKeyOrId, orderBy, statuses, maxResults, startAt, fields, format, and namedCustomFields
 //
 // Returns:
 //   - types.MapOutput: The search results
 //   - error: An error if the request fails
 func (c *JiraClient) SearchIssues(ctx context.Context, input SearchIssuesInput) (types.MapOutput, error) {
+	if err := validateFormat(input.Format); err != nil {
+		return nil, err
+	}
 
 	finalJQL := input.JQL
 	if finalJQL == "" {
@@ -44,14 +47,28 @@ func (c *JiraClient) SearchIssues(ctx context.Context, input SearchIssuesInput)
 	client.SetRequestBodyParam(payload, "jql", finalJQL)
 	client.SetRequestBodyParam(payload, "maxResults", input.MaxResults)
 	client.SetRequestBodyParam(payload, "startAt", input.StartAt)
-	client.SetRequestBodyParam(payload, "fields", input.Fields)
+	fields := input.Fields
+	if input.NamedCustomFields {
+		var err error
+		if fields, err = c.resolveFieldList(ctx, fields); err != nil {
+			return nil, err
+		}
+	}
+	client.SetRequestBodyParam(payload, "fields", fields)
 
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
+	// Decoding into a raw message skips pruning, so that custom fields can be
+	// renamed before they are pruned
 	var output types.MapOutput
+	var raw json.RawMessage
+	result := any(&output)
+	if input.NamedCustomFields {
+		result = &raw
+	}
 	err = client.ExecuteRequest(
 		ctx,
 		c.BaseClient,
@@ -60,11 +77,27 @@ func (c *JiraClient) SearchIssues(ctx context.Context, input SearchIssuesInput)
 		nil,
 		jsonPayload,
 		client.AcceptJSON,
-		&output,
+		result,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if input.NamedCustomFields {
+		if output, err = c.decodeNamedCustomFields(ctx, raw); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Format == FormatMarkdown {
+		if issues, ok := output["issues"].([]any); ok {
+			for _, issue := range issues {
+				if issue, ok := issue.(map[string]any); ok {
+					issueToMarkdown(issue)
+				}
+			}
+		}
+	}
+
 	return output, nil
 }
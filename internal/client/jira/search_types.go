@@ -3,9 +3,11 @@ package jira
 // SearchIssuesInput represents the input parameters for searching issues
 type SearchIssuesInput struct {
 	PaginationInput
-	JQL            string   `json:"jql,omitempty" jsonschema:"The JQL query string"`
-	ProjectKeyOrId string   `json:"projectKeyOrId,omitempty" jsonschema:"The project key or ID to filter by"`
-	OrderBy        string   `json:"orderBy,omitempty" jsonschema:"The field to order results by"`
-	Statuses       []string `json:"statuses,omitempty" jsonschema:"The statuses to filter by"`
-	Fields         []string `json:"fields,omitempty" jsonschema:"The list of fields to return for each issue"`
+	JQL               string   `json:"jql,omitempty" jsonschema:"The JQL query string"`
+	ProjectKeyOrId    string   `json:"projectKeyOrId,omitempty" jsonschema:"The project key or ID to filter by"`
+	OrderBy           string   `json:"orderBy,omitempty" jsonschema:"The field to order results by"`
+	Statuses          []string `json:"statuses,omitempty" jsonschema:"The statuses to filter by"`
+	Fields            []string `json:"fields,omitempty" jsonschema:"The list of fields to return for each issue. Field names are accepted when namedCustomFields is set"`
+	Format            string   `json:"format,omitempty" jsonschema:"The format of the returned descriptions, environments and comment bodies: wiki (default) or markdown"`
+	NamedCustomFields bool     `json:"namedCustomFields,omitempty" jsonschema:"Whether to return custom fields under their names instead of removing them from the response"`
 }
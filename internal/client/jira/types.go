@@ -1,7 +1,31 @@
 package jira
 
+import "atlassian-dc-mcp-go/internal/client"
+
 // PaginationInput represents pagination parameters
 type PaginationInput struct {
-	StartAt    int `json:"startAt,omitempty" jsonschema:"The index of the first item to return"`
-	MaxResults int `json:"maxResults,omitempty" jsonschema:"The maximum number of items to return per page"`
+	StartAt    int  `json:"startAt,omitempty" jsonschema:"The index of the first item to return"`
+	MaxResults int  `json:"maxResults,omitempty" jsonschema:"The maximum number of items to return per page"`
+	FetchAll   bool `json:"fetchAll,omitempty" jsonschema:"Whether to fetch all pages and return their items merged, up to maxItems"`
+	MaxItems   int  `json:"maxItems,omitempty" jsonschema:"The maximum number of items to return when fetchAll is set (default: 500)"`
+}
+
+// PagingStyle returns how Jira pages its results
+func (p PaginationInput) PagingStyle() client.PagingStyle {
+	return client.PagingOffset
+}
+
+// FetchAllPages returns whether all pages are requested and the maximum number of items
+func (p PaginationInput) FetchAllPages() (bool, int) {
+	return p.FetchAll, p.MaxItems
+}
+
+// StartPage returns the first page requested by the input
+func (p PaginationInput) StartPage() client.Page {
+	return client.Page{Start: p.StartAt}
+}
+
+// SetPage sets the page requested by the input
+func (p *PaginationInput) SetPage(page client.Page) {
+	p.StartAt = page.Start
 }
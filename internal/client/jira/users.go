@@ -2,13 +2,18 @@ package jira
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"atlassian-dc-mcp-go/internal/client"
 	"atlassian-dc-mcp-go/internal/types"
 )
 
+// userSearchLimit is the number of candidates considered when resolving a user
+const userSearchLimit = 20
+
 // GetUserByName retrieves a user by their username.
 //
 // Parameters:
@@ -127,3 +132,63 @@ func (c *JiraClient) GetCurrentUser(ctx context.Context) (types.MapOutput, error
 
 	return output, nil
 }
+
+// resolveUser finds the user meant by a username or display name, such as Alex.
+// currentUser resolves to the user of the token. A user whose username, key or
+// display name equals the query is preferred, otherwise the query must match a
+// single user.
+func (c *JiraClient) resolveUser(ctx context.Context, query string) (types.MapOutput, error) {
+	if query == AssigneeCurrentUser {
+		return c.GetCurrentUser(ctx)
+	}
+
+	users, err := c.SearchUsers(ctx, SearchUsersInput{
+		Query:           query,
+		PaginationInput: PaginationInput{MaxResults: userSearchLimit},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users: %w", err)
+	}
+
+	var exact []types.MapOutput
+	for _, user := range users {
+		for _, key := range []string{"name", "key", "displayName"} {
+			if v, ok := user[key].(string); ok && strings.EqualFold(v, query) {
+				exact = append(exact, user)
+				break
+			}
+		}
+	}
+
+	candidates := users
+	if len(exact) > 0 {
+		candidates = exact
+	}
+
+	switch len(candidates) {
+	case 1:
+		return candidates[0], nil
+	case 0:
+		return nil, fmt.Errorf("no user matches %q", query)
+	default:
+		labels := make([]string, len(candidates))
+		for i, user := range candidates {
+			labels[i] = fmt.Sprintf("%v (%v)", user["displayName"], user["name"])
+		}
+		return nil, fmt.Errorf("%q matches several users, use one of the usernames: %s", query, strings.Join(labels, ", "))
+	}
+}
+
+// resolveUsername resolves a username or display name to the user and its username
+func (c *JiraClient) resolveUsername(ctx context.Context, query string) (types.MapOutput, string, error) {
+	user, err := c.resolveUser(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	username, ok := user["name"].(string)
+	if !ok || username == "" {
+		return nil, "", fmt.Errorf("user %q has no username", query)
+	}
+	return user, username, nil
+}
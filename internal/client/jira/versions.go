@@ -0,0 +1,266 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// unresolvedIssuesPageSize is the number of issues fetched per request when
+// collecting the unresolved issues of a version
+const unresolvedIssuesPageSize = 100
+
+// GetProjectVersions retrieves a page of the versions of a project.
+//
+// Parameters:
+//   - input: GetProjectVersionsInput containing projectKey, startAt, maxResults, and orderBy
+//
+// Returns:
+//   - types.MapOutput: The page of versions
+//   - error: An error if the request fails
+func (c *JiraClient) GetProjectVersions(ctx context.Context, input GetProjectVersionsInput) (types.MapOutput, error) {
+	queryParams := url.Values{}
+	client.SetQueryParam(queryParams, "startAt", input.StartAt, 0)
+	client.SetQueryParam(queryParams, "maxResults", input.MaxResults, 0)
+	client.SetQueryParam(queryParams, "orderBy", input.OrderBy, "")
+
+	var output types.MapOutput
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "project", input.ProjectKey, "version"},
+		queryParams,
+		nil,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// CreateVersion creates a version in a project.
+//
+// Parameters:
+//   - input: CreateVersionInput containing projectKey, name, description, startDate, and releaseDate
+//
+// Returns:
+//   - types.MapOutput: The created version data
+//   - error: An error if the request fails
+func (c *JiraClient) CreateVersion(ctx context.Context, input CreateVersionInput) (types.MapOutput, error) {
+	payload := types.MapOutput{
+		"project": input.ProjectKey,
+		"name":    input.Name,
+	}
+	client.SetRequestBodyParam(payload, "description", input.Description)
+	client.SetRequestBodyParam(payload, "startDate", input.StartDate)
+	client.SetRequestBodyParam(payload, "releaseDate", input.ReleaseDate)
+
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	var output types.MapOutput
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPost,
+		[]any{"rest", "api", "2", "version"},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ReleaseVersion marks a version as released. If moveUnresolvedTo is set, the
+// unresolved issues of the version are moved to that version first.
+//
+// Parameters:
+//   - input: ReleaseVersionInput containing versionId, releaseDate, and moveUnresolvedTo
+//
+// Returns:
+//   - *ReleasedVersion: The released version and the keys of the moved issues
+//   - error: An error if a request fails
+func (c *JiraClient) ReleaseVersion(ctx context.Context, input ReleaseVersionInput) (*ReleasedVersion, error) {
+	if input.MoveUnresolvedTo == input.VersionId && input.MoveUnresolvedTo != "" {
+		return nil, fmt.Errorf("cannot move the unresolved issues of version %s to itself", input.VersionId)
+	}
+
+	moved := []string{}
+	if input.MoveUnresolvedTo != "" {
+		keys, err := c.unresolvedIssueKeys(ctx, input.VersionId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find unresolved issues: %w", err)
+		}
+
+		for _, key := range keys {
+			if err := c.moveFixVersion(ctx, key, input.VersionId, input.MoveUnresolvedTo); err != nil {
+				return nil, fmt.Errorf("failed to move %s after moving %d issues: %w", key, len(moved), err)
+			}
+			moved = append(moved, key)
+		}
+	}
+
+	releaseDate := input.ReleaseDate
+	if releaseDate == "" {
+		releaseDate = time.Now().Format(time.DateOnly)
+	}
+	jsonPayload, err := json.Marshal(types.MapOutput{
+		"released":    true,
+		"releaseDate": releaseDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	var output types.MapOutput
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPut,
+		[]any{"rest", "api", "2", "version", input.VersionId},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReleasedVersion{Version: output, MovedIssues: moved}, nil
+}
+
+// unresolvedIssueKeys returns the keys of the unresolved issues with a fix version.
+// All pages are collected before any issue is moved, since moving issues changes
+// the search results.
+func (c *JiraClient) unresolvedIssueKeys(ctx context.Context, versionId string) ([]string, error) {
+	var keys []string
+	for startAt := 0; ; {
+		queryParams := url.Values{}
+		queryParams.Set("jql", fmt.Sprintf("fixVersion = %s AND resolution = Unresolved ORDER BY key", versionId))
+		queryParams.Set("fields", "key")
+		queryParams.Set("startAt", fmt.Sprint(startAt))
+		queryParams.Set("maxResults", fmt.Sprint(unresolvedIssuesPageSize))
+
+		var output struct {
+			Total  int `json:"total"`
+			Issues []struct {
+				Key string `json:"key"`
+			} `json:"issues"`
+		}
+		err := client.ExecuteRequest(
+			ctx,
+			c.BaseClient,
+			http.MethodGet,
+			[]any{"rest", "api", "2", "search"},
+			queryParams,
+			nil,
+			client.AcceptJSON,
+			&output,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range output.Issues {
+			keys = append(keys, issue.Key)
+		}
+		// The server may return fewer issues than requested
+		startAt += len(output.Issues)
+		if len(output.Issues) == 0 || startAt >= output.Total {
+			return keys, nil
+		}
+	}
+}
+
+// moveFixVersion replaces a fix version of an issue with another version
+func (c *JiraClient) moveFixVersion(ctx context.Context, issueKey, fromVersionId, toVersionId string) error {
+	jsonPayload, err := json.Marshal(types.MapOutput{
+		"update": types.MapOutput{
+			"fixVersions": []types.MapOutput{
+				{"remove": types.MapOutput{"id": fromVersionId}},
+				{"add": types.MapOutput{"id": toVersionId}},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	return client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPut,
+		[]any{"rest", "api", "2", "issue", issueKey},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		nil,
+	)
+}
+
+// GetVersionRelatedIssueCounts retrieves the number of issues that are fixed in,
+// affected by and unresolved in a version.
+//
+// Parameters:
+//   - input: GetVersionRelatedIssueCountsInput containing versionId
+//
+// Returns:
+//   - types.MapOutput: The issue counts
+//   - error: An error if a request fails
+func (c *JiraClient) GetVersionRelatedIssueCounts(ctx context.Context, input GetVersionRelatedIssueCountsInput) (types.MapOutput, error) {
+	var counts types.MapOutput
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "version", input.VersionId, "relatedIssueCounts"},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&counts,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var unresolved struct {
+		IssuesUnresolvedCount int `json:"issuesUnresolvedCount"`
+	}
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "version", input.VersionId, "unresolvedIssueCount"},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&unresolved,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if counts == nil {
+		counts = types.MapOutput{}
+	}
+	counts["issuesUnresolvedCount"] = unresolved.IssuesUnresolvedCount
+	return counts, nil
+}
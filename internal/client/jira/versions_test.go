@@ -0,0 +1,63 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestReleaseVersionMovesUnresolvedIssues(t *testing.T) {
+	var moved []string
+	var release map[string]any
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/2/search":
+			// Three unresolved issues served in pages of two
+			startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+			var issues []string
+			for i := startAt; i < min(startAt+2, 3); i++ {
+				issues = append(issues, fmt.Sprintf(`{"key":"ABC-%d"}`, i+1))
+			}
+			fmt.Fprintf(w, `{"total":3,"issues":[%s]}`, strings.Join(issues, ","))
+		case r.Method == http.MethodPut && r.URL.Path == "/rest/api/2/version/100":
+			json.NewDecoder(r.Body).Decode(&release)
+			w.Write([]byte(`{"id":"100","released":true}`))
+		case r.Method == http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			want := `{"update":{"fixVersions":[{"remove":{"id":"100"}},{"add":{"id":"101"}}]}}`
+			if string(body) != want {
+				t.Errorf("update body = %s, want %s", body, want)
+			}
+			moved = append(moved, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	released, err := c.ReleaseVersion(context.Background(), ReleaseVersionInput{VersionId: "100", ReleaseDate: "2024-05-01", MoveUnresolvedTo: "101"})
+	if err != nil {
+		t.Fatalf("ReleaseVersion failed: %v", err)
+	}
+
+	if len(released.MovedIssues) != 3 || len(moved) != 3 || moved[2] != "/rest/api/2/issue/ABC-3" {
+		t.Errorf("moved %v, want ABC-1 to ABC-3", released.MovedIssues)
+	}
+	if release["released"] != true || release["releaseDate"] != "2024-05-01" {
+		t.Errorf("release payload = %v", release)
+	}
+}
+
+func TestReleaseVersionToItself(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	if _, err := c.ReleaseVersion(context.Background(), ReleaseVersionInput{VersionId: "100", MoveUnresolvedTo: "100"}); err == nil {
+		t.Error("ReleaseVersion succeeded, want an error")
+	}
+}
@@ -0,0 +1,37 @@
+package jira
+
+import "atlassian-dc-mcp-go/internal/types"
+
+// GetProjectVersionsInput represents the input parameters for getting the versions of a project
+type GetProjectVersionsInput struct {
+	PaginationInput
+	ProjectKey string `json:"projectKey" jsonschema:"required,The key of the project"`
+	OrderBy    string `json:"orderBy,omitempty" jsonschema:"The field to order the versions by, such as sequence, name or releaseDate. Prefix with - to sort in descending order"`
+}
+
+// CreateVersionInput represents the input parameters for creating a version
+type CreateVersionInput struct {
+	ProjectKey  string `json:"projectKey" jsonschema:"required,The key of the project to create the version in"`
+	Name        string `json:"name" jsonschema:"required,The name of the version"`
+	Description string `json:"description,omitempty" jsonschema:"The description of the version"`
+	StartDate   string `json:"startDate,omitempty" jsonschema:"The start date of the version as YYYY-MM-DD"`
+	ReleaseDate string `json:"releaseDate,omitempty" jsonschema:"The planned release date of the version as YYYY-MM-DD"`
+}
+
+// ReleaseVersionInput represents the input parameters for releasing a version
+type ReleaseVersionInput struct {
+	VersionId        string `json:"versionId" jsonschema:"required,The ID of the version to release"`
+	ReleaseDate      string `json:"releaseDate,omitempty" jsonschema:"The release date as YYYY-MM-DD. Defaults to today"`
+	MoveUnresolvedTo string `json:"moveUnresolvedTo,omitempty" jsonschema:"The ID of the version to move the unresolved issues of the released version to"`
+}
+
+// GetVersionRelatedIssueCountsInput represents the input parameters for getting the issue counts of a version
+type GetVersionRelatedIssueCountsInput struct {
+	VersionId string `json:"versionId" jsonschema:"required,The ID of the version"`
+}
+
+// ReleasedVersion is a released version with the issues moved to another version
+type ReleasedVersion struct {
+	Version     types.MapOutput `json:"version"`
+	MovedIssues []string        `json:"movedIssues"`
+}
@@ -0,0 +1,135 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// GetWatchers retrieves the watchers of an issue.
+//
+// Parameters:
+//   - input: GetWatchersInput containing issueKey
+//
+// Returns:
+//   - types.MapOutput: The watchers, their count and whether the current user is watching
+//   - error: An error if the request fails
+func (c *JiraClient) GetWatchers(ctx context.Context, input GetWatchersInput) (types.MapOutput, error) {
+	var output types.MapOutput
+	err := client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodGet,
+		[]any{"rest", "api", "2", "issue", input.IssueKey, "watchers"},
+		nil,
+		nil,
+		client.AcceptJSON,
+		&output,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// AddWatcher adds a watcher to an issue. The watcher can be given by username or display name.
+//
+// Parameters:
+//   - input: WatcherInput containing issueKey and username
+//
+// Returns:
+//   - types.MapOutput: The result of the operation with the resolved watcher
+//   - error: An error if the request fails
+func (c *JiraClient) AddWatcher(ctx context.Context, input WatcherInput) (types.MapOutput, error) {
+	user, username, err := c.resolveUsername(ctx, input.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	// The request body is the username as a JSON string
+	jsonPayload, err := json.Marshal(username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodPost,
+		[]any{"rest", "api", "2", "issue", input.IssueKey, "watchers"},
+		nil,
+		jsonPayload,
+		client.AcceptJSON,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.MapOutput{"success": true, "watcher": user}, nil
+}
+
+// RemoveWatcher removes a watcher from an issue. The watcher can be given by username or display name.
+//
+// Parameters:
+//   - input: WatcherInput containing issueKey and username
+//
+// Returns:
+//   - types.MapOutput: The result of the operation with the resolved watcher
+//   - error: An error if the request fails
+func (c *JiraClient) RemoveWatcher(ctx context.Context, input WatcherInput) (types.MapOutput, error) {
+	user, username, err := c.resolveUsername(ctx, input.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Set("username", username)
+
+	err = client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		http.MethodDelete,
+		[]any{"rest", "api", "2", "issue", input.IssueKey, "watchers"},
+		queryParams,
+		nil,
+		client.AcceptJSON,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.MapOutput{"success": true, "watcher": user}, nil
+}
+
+// VoteIssue adds or removes the vote of the current user for an issue.
+//
+// Parameters:
+//   - input: VoteIssueInput containing issueKey and remove
+//
+// Returns:
+//   - error: An error if the request fails
+func (c *JiraClient) VoteIssue(ctx context.Context, input VoteIssueInput) error {
+	method := http.MethodPost
+	if input.Remove {
+		method = http.MethodDelete
+	}
+
+	return client.ExecuteRequest(
+		ctx,
+		c.BaseClient,
+		method,
+		[]any{"rest", "api", "2", "issue", input.IssueKey, "votes"},
+		nil,
+		nil,
+		client.AcceptJSON,
+		nil,
+	)
+}
@@ -0,0 +1,97 @@
+package jira
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testUsers = `[
+	{"name":"alex.smith","key":"JIRAUSER1","displayName":"Alex Smith"},
+	{"name":"alexandra","key":"JIRAUSER2","displayName":"Alexandra Jones"}
+]`
+
+func TestAssignIssue(t *testing.T) {
+	tests := []struct {
+		name     string
+		assignee string
+		users    string
+		want     string
+		wantErr  string
+	}{
+		{name: "exact display name", assignee: "alex smith", users: testUsers, want: `{"name":"alex.smith"}`},
+		{name: "single match", assignee: "Jones", users: `[{"name":"alexandra","displayName":"Alexandra Jones"}]`, want: `{"name":"alexandra"}`},
+		{name: "ambiguous", assignee: "Alex", users: testUsers, wantErr: "Alex Smith (alex.smith), Alexandra Jones (alexandra)"},
+		{name: "no match", assignee: "Bob", users: `[]`, wantErr: "no user matches"},
+		{name: "current user", assignee: AssigneeCurrentUser, want: `{"name":"me"}`},
+		{name: "default assignee", assignee: AssigneeDefault, want: `{"name":"-1"}`},
+		{name: "unassign", assignee: AssigneeNone, want: `{"name":null}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/rest/api/2/user/search":
+					if got := r.URL.Query().Get("username"); got != tt.assignee {
+						t.Errorf("username = %q, want %q", got, tt.assignee)
+					}
+					w.Write([]byte(tt.users))
+				case r.URL.Path == "/rest/api/2/myself":
+					w.Write([]byte(`{"name":"me","displayName":"Me"}`))
+				case r.Method == http.MethodPut && r.URL.Path == "/rest/api/2/issue/ABC-1/assignee":
+					b, _ := io.ReadAll(r.Body)
+					body = string(b)
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			_, err := c.AssignIssue(context.Background(), AssignIssueInput{IssueKey: "ABC-1", Assignee: tt.assignee})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("AssignIssue error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("AssignIssue failed: %v", err)
+			}
+			if body != tt.want {
+				t.Errorf("body = %s, want %s", body, tt.want)
+			}
+		})
+	}
+}
+
+func TestWatchers(t *testing.T) {
+	var requests []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/user/search":
+			w.Write([]byte(testUsers))
+		case "/rest/api/2/issue/ABC-1/watchers":
+			b, _ := io.ReadAll(r.Body)
+			requests = append(requests, r.Method+" "+r.URL.RawQuery+string(b))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	if _, err := c.AddWatcher(context.Background(), WatcherInput{IssueKey: "ABC-1", Username: "Alexandra Jones"}); err != nil {
+		t.Fatalf("AddWatcher failed: %v", err)
+	}
+	if _, err := c.RemoveWatcher(context.Background(), WatcherInput{IssueKey: "ABC-1", Username: "alex.smith"}); err != nil {
+		t.Fatalf("RemoveWatcher failed: %v", err)
+	}
+
+	want := []string{`POST "alexandra"`, `DELETE username=alex.smith`}
+	if strings.Join(requests, "|") != strings.Join(want, "|") {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
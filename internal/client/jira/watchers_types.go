@@ -0,0 +1,18 @@
+package jira
+
+// GetWatchersInput represents the input parameters for getting the watchers of an issue
+type GetWatchersInput struct {
+	IssueKey string `json:"issueKey" jsonschema:"required,The key of the issue"`
+}
+
+// WatcherInput represents the input parameters for adding or removing a watcher of an issue
+type WatcherInput struct {
+	IssueKey string `json:"issueKey" jsonschema:"required,The key of the issue"`
+	Username string `json:"username" jsonschema:"required,The username or display name of the watcher. Use currentUser for yourself"`
+}
+
+// VoteIssueInput represents the input parameters for voting for an issue
+type VoteIssueInput struct {
+	IssueKey string `json:"issueKey" jsonschema:"required,The key of the issue to vote for"`
+	Remove   bool   `json:"remove,omitempty" jsonschema:"Whether to remove your vote instead of adding it"`
+}
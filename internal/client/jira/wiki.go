@@ -0,0 +1,773 @@
+package jira
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"atlassian-dc-mcp-go/internal/markdown"
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// Text formats accepted and returned by the issue and comment tools
+const (
+	FormatWiki     = "wiki"
+	FormatMarkdown = "markdown"
+)
+
+var (
+	// wikiHeadingPattern matches a heading line such as "h2. Title"
+	wikiHeadingPattern = regexp.MustCompile(`^h([1-6])\.\s*(.*)$`)
+	// wikiListPattern matches a list item line such as "** item" or "# item"
+	wikiListPattern = regexp.MustCompile(`^([*#]+|-)\s+(.*)$`)
+	// wikiBlockMacroPattern matches the opening tag of a block macro such as "{code:java}"
+	wikiBlockMacroPattern = regexp.MustCompile(`^\{(code|noformat|quote|panel|info|note|tip|warning)(?::([^}]*))?\}`)
+	// wikiInlineMacroPattern matches the tags of inline macros that have no Markdown equivalent
+	wikiInlineMacroPattern = regexp.MustCompile(`^\{(?:color(?::[^}]*)?|anchor:[^}]*)\}`)
+	// wikiImagePattern matches an image such as "!diagram.png|thumbnail!"
+	wikiImagePattern = regexp.MustCompile(`^!([^\s!|]+)(?:\|([^!\n]*))?!`)
+	// wikiLineStartPattern matches text at the start of a line that would start a block.
+	// List markers only start a list when whitespace follows, so "*bold*" is kept.
+	wikiLineStartPattern = regexp.MustCompile(`^(?:[*#]+\s|-\s|h[1-6]\.|bq\.\s)`)
+)
+
+// wikiBackslash is the entity that writes a literal backslash
+const wikiBackslash = "&#92;"
+
+// wikiEffects maps the text effect markers of wiki markup to Markdown node kinds.
+// Effects without a Markdown equivalent, such as +inserted+ or ^superscript^, map
+// to Document and are reduced to their content.
+var wikiEffects = map[string]markdown.Kind{
+	"*":  markdown.Strong,
+	"_":  markdown.Emphasis,
+	"??": markdown.Emphasis,
+	"-":  markdown.Strikethrough,
+	"+":  markdown.Document,
+	"^":  markdown.Document,
+	"~":  markdown.Document,
+}
+
+// validateFormat checks that a text format is supported
+func validateFormat(format string) error {
+	switch format {
+	case "", FormatWiki, FormatMarkdown:
+		return nil
+	default:
+		return fmt.Errorf("unsupported format %q, expected %q or %q", format, FormatWiki, FormatMarkdown)
+	}
+}
+
+// issueToMarkdown converts the description, environment and comments of an issue
+// from wiki markup to Markdown
+func issueToMarkdown(issue types.MapOutput) {
+	fields, ok := issue["fields"].(map[string]any)
+	if !ok {
+		return
+	}
+	for _, key := range []string{"description", "environment"} {
+		if value, ok := fields[key].(string); ok {
+			fields[key] = WikiToMarkdown(value)
+		}
+	}
+	if comment, ok := fields["comment"].(map[string]any); ok {
+		commentsToMarkdown(comment)
+	}
+}
+
+// fieldsToWiki returns a copy of the fields of an issue update with the
+// description and environment converted from Markdown to wiki markup
+func fieldsToWiki(fields types.MapOutput) types.MapOutput {
+	converted := make(types.MapOutput, len(fields))
+	for key, value := range fields {
+		if text, ok := value.(string); ok && (key == "description" || key == "environment") {
+			value = MarkdownToWiki(text)
+		}
+		converted[key] = value
+	}
+	return converted
+}
+
+// commentsToMarkdown converts the bodies of a page of comments from wiki markup to Markdown
+func commentsToMarkdown(page types.MapOutput) {
+	comments, ok := page["comments"].([]any)
+	if !ok {
+		return
+	}
+	for _, comment := range comments {
+		if comment, ok := comment.(map[string]any); ok {
+			commentToMarkdown(comment)
+		}
+	}
+}
+
+// commentToMarkdown converts the body of a comment from wiki markup to Markdown
+func commentToMarkdown(comment types.MapOutput) {
+	if body, ok := comment["body"].(string); ok {
+		comment["body"] = WikiToMarkdown(body)
+	}
+}
+
+// MarkdownToWiki converts Markdown to Jira wiki markup.
+// Code blocks with a language become {code} macros, other code blocks become
+// {noformat} macros and block quotes become {quote} macros. Wiki markup has no
+// column alignment or task lists, so tables lose their alignment and tasks are
+// written as "[ ]" and "[x]" text.
+func MarkdownToWiki(md string) string {
+	var b strings.Builder
+	writeWikiBlocks(&b, markdown.Parse(md).Children)
+	return b.String()
+}
+
+func writeWikiBlocks(b *strings.Builder, blocks []*markdown.Node) {
+	for i, n := range blocks {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		writeWikiBlock(b, n)
+	}
+}
+
+func writeWikiBlock(b *strings.Builder, n *markdown.Node) {
+	switch n.Kind {
+	case markdown.Paragraph:
+		var p strings.Builder
+		writeWikiInlines(&p, n.Children, "\n")
+		lines := strings.Split(strings.TrimSpace(p.String()), "\n")
+		for i, line := range lines {
+			lines[i] = escapeWikiLineStart(strings.TrimLeft(line, " "))
+		}
+		b.WriteString(strings.Join(lines, "\n"))
+	case markdown.Heading:
+		fmt.Fprintf(b, "h%d. ", n.Level)
+		writeWikiInlines(b, n.Children, " ")
+	case markdown.CodeBlock:
+		writeWikiCode(b, strings.TrimSuffix(n.Text, "\n"), n.Language)
+	case markdown.BlockQuote:
+		b.WriteString("{quote}\n")
+		writeWikiBlocks(b, n.Children)
+		b.WriteString("\n{quote}")
+	case markdown.List:
+		writeWikiList(b, n, "")
+	case markdown.Table:
+		writeWikiTable(b, n)
+	case markdown.ThematicBreak:
+		b.WriteString("----")
+	}
+}
+
+// writeWikiCode writes a code block as a {code} macro if it has a language and
+// as a {noformat} macro otherwise. Jira ends a macro at the first closing tag, so
+// code that contains the closing tag is written with the other macro, losing its
+// language. Code that contains both tags is written as a {code} macro with the
+// braces of its closing tags escaped.
+func writeWikiCode(b *strings.Builder, code, language string) {
+	hasCode, hasNoformat := strings.Contains(code, "{code}"), strings.Contains(code, "{noformat}")
+	switch {
+	case language != "" && !hasCode:
+		b.WriteString("{code:" + language + "}\n" + code + "\n{code}")
+	case !hasNoformat:
+		b.WriteString("{noformat}\n" + code + "\n{noformat}")
+	case !hasCode:
+		b.WriteString("{code}\n" + code + "\n{code}")
+	default:
+		tag := "{code}"
+		if language != "" {
+			tag = "{code:" + language + "}"
+		}
+		b.WriteString(tag + "\n" + strings.ReplaceAll(code, "{code}", `\{code\}`) + "\n{code}")
+	}
+}
+
+// writeWikiList writes a list. Nested lists repeat the markers of their parents,
+// as in "*#" for a numbered list inside a bulleted list. The blocks of an item are
+// kept on a single line separated by forced line breaks.
+func writeWikiList(b *strings.Builder, n *markdown.Node, prefix string) {
+	marker := prefix + "*"
+	if n.Ordered {
+		marker = prefix + "#"
+	}
+
+	for i, item := range n.Children {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(marker + " ")
+		if item.Task {
+			if item.Checked {
+				b.WriteString(`\[x\] `)
+			} else {
+				b.WriteString(`\[ \] `)
+			}
+		}
+
+		inline := true
+		for j, child := range item.Children {
+			switch child.Kind {
+			case markdown.List:
+				b.WriteString("\n")
+				writeWikiList(b, child, marker)
+				inline = false
+			case markdown.Paragraph:
+				if j > 0 {
+					if inline {
+						b.WriteString(` \\ `)
+					} else {
+						b.WriteString("\n")
+					}
+				}
+				writeWikiInlines(b, child.Children, `\\ `)
+			default:
+				b.WriteString("\n")
+				writeWikiBlock(b, child)
+				inline = false
+			}
+		}
+	}
+}
+
+func writeWikiTable(b *strings.Builder, n *markdown.Node) {
+	for r, row := range n.Children {
+		if r > 0 {
+			b.WriteString("\n")
+		}
+		separator := "|"
+		for _, cell := range row.Children {
+			separator = "|"
+			if cell.Header {
+				separator = "||"
+			}
+
+			var text strings.Builder
+			writeWikiInlines(&text, cell.Children, `\\ `)
+			content := strings.TrimSpace(text.String())
+			if content == "" {
+				content = " "
+			}
+			b.WriteString(separator + content)
+		}
+		b.WriteString(separator)
+	}
+}
+
+// writeWikiInlines writes inline nodes, writing lineBreak for hard line breaks.
+// Soft line breaks become spaces because Jira renders every newline as a line break.
+func writeWikiInlines(b *strings.Builder, nodes []*markdown.Node, lineBreak string) {
+	for _, n := range nodes {
+		switch n.Kind {
+		case markdown.Text:
+			b.WriteString(escapeWikiText(strings.ReplaceAll(n.Text, "\n", " ")))
+		case markdown.Emphasis:
+			writeWikiEffect(b, n, "_", lineBreak)
+		case markdown.Strong:
+			writeWikiEffect(b, n, "*", lineBreak)
+		case markdown.Strikethrough:
+			writeWikiEffect(b, n, "-", lineBreak)
+		case markdown.Code:
+			b.WriteString("{{" + escapeWikiText(n.Text) + "}}")
+		case markdown.Link:
+			if len(n.Children) == 0 || n.PlainText() == n.URL {
+				b.WriteString("[" + n.URL + "]")
+				continue
+			}
+			b.WriteString("[")
+			writeWikiInlines(b, n.Children, " ")
+			b.WriteString("|" + n.URL + "]")
+		case markdown.Image:
+			b.WriteString("!" + n.URL)
+			if n.Text != "" {
+				b.WriteString("|alt=" + strings.NewReplacer("|", " ", "!", "", ",", " ").Replace(n.Text))
+			}
+			b.WriteString("!")
+		case markdown.LineBreak:
+			b.WriteString(lineBreak)
+		}
+	}
+}
+
+// writeWikiEffect surrounds the content of a node with an effect marker, keeping
+// surrounding spaces outside of the markers so that the effect is recognized
+func writeWikiEffect(b *strings.Builder, n *markdown.Node, marker, lineBreak string) {
+	var content strings.Builder
+	writeWikiInlines(&content, n.Children, lineBreak)
+	text := content.String()
+
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		b.WriteString(text)
+		return
+	}
+	start := strings.Index(text, trimmed)
+	b.WriteString(text[:start] + marker + trimmed + marker + text[start+len(trimmed):])
+}
+
+// escapeWikiText escapes the characters of text that would be read as wiki markup.
+// Effect markers are only escaped where they could open or close an effect, so
+// that hyphenated words and snake_case names are kept as they are. Backslashes
+// are written as an entity because two of them are a line break.
+func escapeWikiText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '\\':
+			b.WriteString(wikiBackslash)
+			continue
+		case '{', '}', '[', ']', '|':
+			b.WriteByte('\\')
+		case '*', '_', '-', '+', '^', '~':
+			opens := (i == 0 || !isWikiWordChar(s[i-1])) && i+1 < len(s) && s[i+1] != ' '
+			closes := i > 0 && s[i-1] != ' ' && (i+1 == len(s) || !isWikiWordChar(s[i+1]))
+			if opens || closes {
+				b.WriteByte('\\')
+			}
+		case '!':
+			if i+1 < len(s) && s[i+1] != ' ' {
+				b.WriteByte('\\')
+			}
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// escapeWikiLineStart escapes text at the start of a line of a paragraph that
+// would start another block, such as a heading or a list item
+func escapeWikiLineStart(line string) string {
+	if wikiLineStartPattern.MatchString(line) || line == "----" {
+		return `\` + line
+	}
+	return line
+}
+
+// WikiToMarkdown converts Jira wiki markup to Markdown.
+// Newlines within paragraphs become hard line breaks, as Jira renders them, and
+// effects and macros without a Markdown equivalent, such as colors, are reduced
+// to their content. Info, note, tip, warning and panel macros become block quotes.
+func WikiToMarkdown(wiki string) string {
+	wiki = strings.ReplaceAll(wiki, "\r\n", "\n")
+	doc := &markdown.Node{Kind: markdown.Document, Children: parseWikiBlocks(wiki)}
+	return markdown.Render(doc)
+}
+
+// startsWikiBlock reports whether a line starts a block other than a paragraph
+func startsWikiBlock(line string) bool {
+	line = strings.TrimSpace(line)
+	return line == "" || line == "----" || strings.HasPrefix(line, "|") || strings.HasPrefix(line, "bq. ") ||
+		wikiHeadingPattern.MatchString(line) || wikiListPattern.MatchString(line) ||
+		wikiBlockMacroPattern.MatchString(line)
+}
+
+func parseWikiBlocks(wiki string) []*markdown.Node {
+	var blocks []*markdown.Node
+	lines := strings.Split(wiki, "\n")
+	for i := 0; i < len(lines); {
+		line := strings.TrimSpace(lines[i])
+		switch {
+		case line == "":
+			i++
+		case line == "----":
+			blocks = append(blocks, &markdown.Node{Kind: markdown.ThematicBreak})
+			i++
+		case wikiHeadingPattern.MatchString(line):
+			m := wikiHeadingPattern.FindStringSubmatch(line)
+			level := int(m[1][0] - '0')
+			blocks = append(blocks, &markdown.Node{Kind: markdown.Heading, Level: level, Children: parseWikiInline(m[2])})
+			i++
+		case strings.HasPrefix(line, "bq. "):
+			paragraph := &markdown.Node{Kind: markdown.Paragraph, Children: parseWikiInline(strings.TrimPrefix(line, "bq. "))}
+			blocks = append(blocks, &markdown.Node{Kind: markdown.BlockQuote, Children: []*markdown.Node{paragraph}})
+			i++
+		case wikiBlockMacroPattern.MatchString(line):
+			// The macro may span several lines and be followed by more text on the
+			// line of its closing tag, so the remaining lines are split again
+			block, rest := parseWikiMacro(strings.TrimLeft(strings.Join(lines[i:], "\n"), " \t"))
+			blocks = append(blocks, block)
+			lines, i = strings.Split(rest, "\n"), 0
+		case wikiListPattern.MatchString(line):
+			list, n := parseWikiList(lines[i:])
+			blocks = append(blocks, list)
+			i += n
+		case strings.HasPrefix(line, "|"):
+			table, n := parseWikiTable(lines[i:])
+			blocks = append(blocks, table)
+			i += n
+		default:
+			content := []string{line}
+			for i++; i < len(lines) && !startsWikiBlock(lines[i]); i++ {
+				content = append(content, strings.TrimSpace(lines[i]))
+			}
+			blocks = append(blocks, &markdown.Node{Kind: markdown.Paragraph, Children: parseWikiInline(strings.Join(content, "\n"))})
+		}
+	}
+	return blocks
+}
+
+// parseWikiMacro parses a block macro at the start of s and returns it with the
+// text that follows its closing tag
+func parseWikiMacro(s string) (*markdown.Node, string) {
+	m := wikiBlockMacroPattern.FindStringSubmatch(s)
+	name := m[1]
+	params := wikiMacroParameters(m[2])
+
+	body, rest := s[len(m[0]):], ""
+	if end := strings.Index(body, "{"+name+"}"); end >= 0 {
+		body, rest = body[:end], body[end+len(name)+2:]
+	}
+
+	switch name {
+	case "code", "noformat":
+		code := strings.TrimPrefix(strings.TrimSuffix(body, "\n"), "\n")
+		if name == "code" {
+			code = strings.ReplaceAll(code, `\{code\}`, "{code}")
+		}
+		return &markdown.Node{Kind: markdown.CodeBlock, Text: code, Language: params["language"]}, rest
+	case "quote":
+		return &markdown.Node{Kind: markdown.BlockQuote, Children: parseWikiBlocks(body)}, rest
+	default:
+		children := parseWikiBlocks(body)
+		title := params["title"]
+		if title == "" && name != "panel" {
+			title = strings.ToUpper(name[:1]) + name[1:]
+		}
+		if title != "" {
+			heading := &markdown.Node{Kind: markdown.Paragraph, Children: []*markdown.Node{
+				{Kind: markdown.Strong, Children: []*markdown.Node{{Kind: markdown.Text, Text: title}}},
+			}}
+			children = append([]*markdown.Node{heading}, children...)
+		}
+		return &markdown.Node{Kind: markdown.BlockQuote, Children: children}, rest
+	}
+}
+
+// wikiMacroParameters parses macro parameters such as "title=Notes|borderStyle=solid".
+// A parameter without a name, as in {code:java}, is the language.
+func wikiMacroParameters(s string) map[string]string {
+	params := map[string]string{}
+	for _, param := range strings.Split(s, "|") {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok {
+			name, value = "language", param
+		}
+		params[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return params
+}
+
+// parseWikiList parses consecutive list item lines into a list. Lines that don't
+// start a block continue the previous item after a line break.
+func parseWikiList(lines []string) (*markdown.Node, int) {
+	var root *markdown.Node
+	// stack holds the open lists, one per nesting level
+	var stack []*markdown.Node
+
+	i := 0
+	for ; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		m := wikiListPattern.FindStringSubmatch(line)
+		if m == nil {
+			if startsWikiBlock(line) || len(stack) == 0 {
+				break
+			}
+			items := stack[len(stack)-1].Children
+			paragraph := items[len(items)-1].Children[0]
+			paragraph.Children = append(paragraph.Children, &markdown.Node{Kind: markdown.LineBreak})
+			paragraph.Children = append(paragraph.Children, parseWikiInline(line)...)
+			continue
+		}
+
+		markers := m[1]
+		if markers == "-" {
+			markers = "*"
+		}
+		if root != nil && (markers[0] == '#') != root.Ordered {
+			break
+		}
+
+		if len(stack) > len(markers) {
+			stack = stack[:len(markers)]
+		}
+		for depth := range markers {
+			ordered := markers[depth] == '#'
+			if depth < len(stack) && stack[depth].Ordered == ordered {
+				continue
+			}
+
+			list := &markdown.Node{Kind: markdown.List, Ordered: ordered, Tight: true}
+			if depth == 0 {
+				root = list
+			} else {
+				parent := stack[depth-1]
+				if len(parent.Children) == 0 {
+					parent.Children = append(parent.Children, wikiListItem(""))
+				}
+				item := parent.Children[len(parent.Children)-1]
+				item.Children = append(item.Children, list)
+			}
+			stack = append(stack[:depth], list)
+		}
+
+		list := stack[len(stack)-1]
+		list.Children = append(list.Children, wikiListItem(m[2]))
+	}
+	return root, i
+}
+
+// wikiListItem creates a list item. Items that start with escaped "[ ]" or "[x]",
+// as written by MarkdownToWiki, are task list items.
+func wikiListItem(text string) *markdown.Node {
+	item := &markdown.Node{Kind: markdown.ListItem}
+	for prefix, checked := range map[string]bool{`\[ \] `: false, `\[x\] `: true} {
+		if strings.HasPrefix(text, prefix) {
+			item.Task, item.Checked = true, checked
+			text = strings.TrimPrefix(text, prefix)
+		}
+	}
+
+	paragraph := &markdown.Node{Kind: markdown.Paragraph, Children: parseWikiInline(text)}
+	item.Children = []*markdown.Node{paragraph}
+	return item
+}
+
+// parseWikiTable parses consecutive table row lines. Cells that start with "||"
+// are header cells.
+func parseWikiTable(lines []string) (*markdown.Node, int) {
+	table := &markdown.Node{Kind: markdown.Table}
+	i := 0
+	for ; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(line, "|") {
+			break
+		}
+
+		row := &markdown.Node{Kind: markdown.TableRow}
+		for _, cell := range splitWikiRow(line) {
+			row.Children = append(row.Children, cell)
+		}
+		table.Children = append(table.Children, row)
+	}
+	return table, i
+}
+
+// splitWikiRow splits a table row into cells. Separators inside links, images
+// and macros, as in [text|url], don't end a cell.
+func splitWikiRow(line string) []*markdown.Node {
+	var cells []*markdown.Node
+	for i := 0; i < len(line); {
+		header := strings.HasPrefix(line[i:], "||")
+		if header {
+			i += 2
+		} else {
+			i++
+		}
+
+		start, depth := i, 0
+	scan:
+		for ; i < len(line); i++ {
+			switch line[i] {
+			case '\\':
+				i++
+			case '!':
+				if m := wikiImagePattern.FindString(line[i:]); m != "" {
+					i += len(m) - 1
+				}
+			case '[', '{':
+				depth++
+			case ']', '}':
+				depth = max(0, depth-1)
+			case '|':
+				if depth == 0 {
+					break scan
+				}
+			}
+		}
+		content := strings.TrimSpace(line[start:min(i, len(line))])
+		if i >= len(line) && content == "" {
+			break
+		}
+		cells = append(cells, &markdown.Node{Kind: markdown.TableCell, Header: header, Children: parseWikiInline(content)})
+	}
+	return cells
+}
+
+// parseWikiInline parses the inline content of a block
+func parseWikiInline(s string) []*markdown.Node {
+	var nodes []*markdown.Node
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, &markdown.Node{Kind: markdown.Text, Text: text.String()})
+			text.Reset()
+		}
+	}
+	add := func(n ...*markdown.Node) {
+		flush()
+		nodes = append(nodes, n...)
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case strings.HasPrefix(s[i:], `\\`):
+			add(&markdown.Node{Kind: markdown.LineBreak})
+			i += 2
+			for i < len(s) && (s[i] == ' ' || s[i] == '\n') {
+				i++
+			}
+			continue
+		case c == '\\' && i+1 < len(s):
+			text.WriteByte(s[i+1])
+			i += 2
+			continue
+		case strings.HasPrefix(s[i:], wikiBackslash):
+			text.WriteByte('\\')
+			i += len(wikiBackslash)
+			continue
+		case c == '\n':
+			add(&markdown.Node{Kind: markdown.LineBreak})
+			i++
+			continue
+		case strings.HasPrefix(s[i:], "{{"):
+			if end := indexUnescaped(s[i+2:], "}}"); end > 0 {
+				add(&markdown.Node{Kind: markdown.Code, Text: unescapeWiki(s[i+2 : i+2+end])})
+				i += end + 4
+				continue
+			}
+		case c == '{':
+			if m := wikiInlineMacroPattern.FindString(s[i:]); m != "" {
+				i += len(m)
+				continue
+			}
+		case c == '[':
+			if end := strings.IndexByte(s[i:], ']'); end > 0 {
+				add(wikiLink(s[i+1 : i+end])...)
+				i += end + 1
+				continue
+			}
+		case c == '!':
+			if m := wikiImagePattern.FindStringSubmatch(s[i:]); m != nil {
+				alt := wikiMacroParameters(strings.ReplaceAll(m[2], ",", "|"))["alt"]
+				add(&markdown.Node{Kind: markdown.Image, URL: m[1], Text: alt})
+				i += len(m[0])
+				continue
+			}
+		default:
+			if n, end := parseWikiEffect(s, i); n != nil {
+				add(n...)
+				i = end
+				continue
+			}
+		}
+		text.WriteByte(c)
+		i++
+	}
+	flush()
+	return nodes
+}
+
+// parseWikiEffect parses a text effect such as *strong* starting at s[i] and
+// returns its nodes and the end of the effect
+func parseWikiEffect(s string, i int) ([]*markdown.Node, int) {
+	marker := s[i : i+1]
+	if strings.HasPrefix(s[i:], "??") {
+		marker = "??"
+	}
+	kind, ok := wikiEffects[marker]
+	if !ok {
+		return nil, i
+	}
+
+	// An effect opens after a word boundary and before a non-space character
+	start := i + len(marker)
+	if (i > 0 && isWikiWordChar(s[i-1])) || start >= len(s) || s[start] == ' ' || s[start] == '\n' {
+		return nil, i
+	}
+
+	// and closes after a non-space character and before a word boundary
+	for j := start + 1; j < len(s); j++ {
+		switch {
+		case s[j] == '\n':
+			return nil, i
+		case s[j] == '\\':
+			j++
+		case strings.HasPrefix(s[j:], marker) && s[j-1] != ' ':
+			end := j + len(marker)
+			if end < len(s) && isWikiWordChar(s[end]) {
+				continue
+			}
+			children := parseWikiInline(s[start:j])
+			if kind == markdown.Document {
+				return children, end
+			}
+			return []*markdown.Node{{Kind: kind, Children: children}}, end
+		}
+	}
+	return nil, i
+}
+
+// wikiLink converts the content of a link such as [text|url]. User mentions
+// become @name text and links to issues without a text become the issue key.
+func wikiLink(content string) []*markdown.Node {
+	alias, target, ok := strings.Cut(content, "|")
+	if !ok {
+		alias, target = "", content
+	}
+	target, _, _ = strings.Cut(target, "|")
+	target = strings.TrimSpace(target)
+
+	switch {
+	case strings.HasPrefix(target, "~"):
+		name := alias
+		if name == "" {
+			name = "@" + strings.TrimPrefix(target, "~")
+		}
+		return []*markdown.Node{{Kind: markdown.Text, Text: name}}
+	case strings.HasPrefix(target, "^"):
+		target = strings.TrimPrefix(target, "^")
+	case alias == "" && !strings.Contains(target, ":") && !strings.HasPrefix(target, "#"):
+		return []*markdown.Node{{Kind: markdown.Text, Text: target}}
+	}
+
+	children := []*markdown.Node{{Kind: markdown.Text, Text: target}}
+	if alias != "" {
+		children = parseWikiInline(alias)
+	}
+	return []*markdown.Node{{Kind: markdown.Link, URL: target, Children: children}}
+}
+
+// unescapeWiki removes the backslashes of escaped characters
+func unescapeWiki(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if strings.HasPrefix(s[i:], wikiBackslash) {
+			b.WriteByte('\\')
+			i += len(wikiBackslash) - 1
+			continue
+		}
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// indexUnescaped returns the index of the first occurrence of token in s that
+// isn't escaped with a backslash, or -1 if there is none
+func indexUnescaped(s, token string) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.HasPrefix(s[i:], token) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isWikiWordChar reports whether c is part of a word, so that effect markers
+// next to it are read as text
+func isWikiWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
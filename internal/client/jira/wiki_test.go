@@ -0,0 +1,182 @@
+package jira
+
+import "testing"
+
+func TestMarkdownToWikiRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		md   string
+		wiki string
+		// back is the expected Markdown after the round trip if it differs from md
+		back string
+	}{
+		{
+			name: "headings",
+			md:   "# Title\n\n### Sub *em*",
+			wiki: "h1. Title\n\nh3. Sub _em_",
+		},
+		{
+			name: "bold at line start",
+			md:   "**Note:** read this",
+			wiki: "*Note:* read this",
+		},
+		{
+			name: "nested lists",
+			md:   "- one\n- two\n  1. nested",
+			wiki: "* one\n* two\n*# nested",
+		},
+		{
+			name: "task list",
+			md:   "- [ ] todo\n- [x] done",
+			wiki: `* \[ \] todo` + "\n" + `* \[x\] done`,
+		},
+		{
+			name: "table",
+			md:   "| A | B |\n| --- | --- |\n| 1 | `x` |",
+			wiki: "||A||B||\n|1|{{x}}|",
+		},
+		{
+			name: "links",
+			md:   "[site](https://example.com) and <https://example.com>",
+			wiki: "[site|https://example.com] and [https://example.com]",
+		},
+		{
+			name: "inline code with braces",
+			md:   "Use `a{b}` here",
+			wiki: `Use {{a\{b\}}} here`,
+		},
+		{
+			name: "code block with language",
+			md:   "```java\nint a = 1;\n```",
+			wiki: "{code:java}\nint a = 1;\n{code}",
+		},
+		{
+			name: "code block without language",
+			md:   "```\nplain\n```",
+			wiki: "{noformat}\nplain\n{noformat}",
+		},
+		{
+			name: "code block containing the code terminator",
+			md:   "```java\nint a = 1; {code}\n```",
+			wiki: "{noformat}\nint a = 1; {code}\n{noformat}",
+			back: "```\nint a = 1; {code}\n```",
+		},
+		{
+			name: "code block containing the noformat terminator",
+			md:   "```\nx {noformat} y\n```",
+			wiki: "{code}\nx {noformat} y\n{code}",
+		},
+		{
+			name: "code block containing both terminators",
+			md:   "```java\n{code} and {noformat}\n```",
+			wiki: "{code:java}\n" + `\{code\}` + " and {noformat}\n{code}",
+		},
+		{
+			name: "block quote",
+			md:   "> quoted",
+			wiki: "{quote}\nquoted\n{quote}",
+		},
+		{
+			name: "escaped markup characters",
+			md:   `a \[b\] {c} | d \\ e`,
+			wiki: `a \[b\] \{c\} \| d &#92; e`,
+			back: `a \[b\] {c} | d \\ e`,
+		},
+		{
+			name: "text that would start a block",
+			md:   "\\- not a list\n\nh1. not a heading",
+			wiki: "\\- not a list\n\n\\h1. not a heading",
+		},
+		{
+			name: "effect markers inside words",
+			md:   "snake_case and x-y",
+			wiki: "snake_case and x-y",
+		},
+		{
+			name: "thematic break",
+			md:   "---",
+			wiki: "----",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wiki := MarkdownToWiki(tt.md)
+			if wiki != tt.wiki {
+				t.Errorf("MarkdownToWiki(%q) = %q, want %q", tt.md, wiki, tt.wiki)
+			}
+
+			want := tt.back
+			if want == "" {
+				want = tt.md
+			}
+			if back := WikiToMarkdown(wiki); back != want {
+				t.Errorf("WikiToMarkdown(%q) = %q, want %q", wiki, back, want)
+			}
+		})
+	}
+}
+
+func TestWikiToMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		wiki string
+		md   string
+	}{
+		{
+			name: "effects",
+			wiki: "*bold* _em_ -strike- {{code}}",
+			md:   "**bold** *em* ~~strike~~ `code`",
+		},
+		{
+			name: "mentions, issue keys and links",
+			wiki: "[~jdoe] see [ABC-1] [text|http://x]",
+			md:   "@jdoe see ABC-1 [text](http://x)",
+		},
+		{
+			name: "info panel",
+			wiki: "{info:title=Heads up}\nCareful\n{info}",
+			md:   "> **Heads up**\n>\n> Careful",
+		},
+		{
+			name: "panel without title",
+			wiki: "{panel}\nBody\n{panel}",
+			md:   "> Body",
+		},
+		{
+			name: "line breaks",
+			wiki: "one\\\\ two\nthree",
+			md:   "one\\\ntwo\\\nthree",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if md := WikiToMarkdown(tt.wiki); md != tt.md {
+				t.Errorf("WikiToMarkdown(%q) = %q, want %q", tt.wiki, md, tt.md)
+			}
+		})
+	}
+}
+
+func TestFieldsToWiki(t *testing.T) {
+	fields := map[string]any{
+		"summary":     "**not converted**",
+		"description": "**Note:** read",
+		"environment": "`go1.22`",
+	}
+
+	converted := fieldsToWiki(fields)
+	if converted["summary"] != "**not converted**" {
+		t.Errorf("summary = %q, want it unchanged", converted["summary"])
+	}
+	if converted["description"] != "*Note:* read" {
+		t.Errorf("description = %q, want %q", converted["description"], "*Note:* read")
+	}
+	if converted["environment"] != "{{go1.22}}" {
+		t.Errorf("environment = %q, want %q", converted["environment"], "{{go1.22}}")
+	}
+	if fields["description"] != "**Note:** read" {
+		t.Errorf("fieldsToWiki modified its input")
+	}
+}
@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// PagingStyle identifies how an API pages its list results
+type PagingStyle int
+
+const (
+	// PagingOffset pages with startAt, maxResults, total and isLast, as Jira does
+	PagingOffset PagingStyle = iota
+	// PagingNextStart pages with isLastPage and nextPageStart, as Bitbucket does
+	PagingNextStart
+	// PagingLinks pages with start and a _links.next link that may carry a cursor, as Confluence does
+	PagingLinks
+)
+
+// DefaultMaxItems is the number of items fetched when fetchAll is set without maxItems
+const DefaultMaxItems = 500
+
+// itemKeys are the keys that hold the items of a page, in order of preference
+var itemKeys = []string{"values", "issues", "results", "comments", "worklogs", "users"}
+
+// Page identifies a page of a list call
+type Page struct {
+	Start  int
+	Cursor string
+}
+
+// Pageable is implemented by the pagination inputs of the clients. List tools with
+// a pageable input fetch and merge all pages when fetchAll is set.
+type Pageable interface {
+	// PagingStyle returns how the API of the client pages its results
+	PagingStyle() PagingStyle
+	// FetchAllPages returns whether all pages are requested and the maximum number of items
+	FetchAllPages() (fetchAll bool, maxItems int)
+	// StartPage returns the first page requested by the input
+	StartPage() Page
+	// SetPage sets the page requested by the input
+	SetPage(page Page)
+}
+
+// FetchAll fetches consecutive pages, starting with start, until the last page or
+// maxItems items, and merges them into a single result. The merged result keeps
+// the fields of the first page, holds the items of all pages and reports with
+// truncated whether items were left out. Where the API supports it, the paging
+// fields point at the first item that was left out.
+func FetchAll(ctx context.Context, style PagingStyle, start Page, maxItems int, fetch func(ctx context.Context, page Page) (types.MapOutput, error)) (types.MapOutput, error) {
+	if maxItems <= 0 {
+		maxItems = DefaultMaxItems
+	}
+
+	var merged types.MapOutput
+	var key string
+	var items []any
+	page := start
+	for {
+		output, err := fetch(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if merged == nil {
+			merged = output
+			if key = itemsKey(output); key == "" {
+				// Not a paged result
+				return output, nil
+			}
+		}
+
+		pageItems, _ := output[key].([]any)
+		next, more := nextPage(style, page, output, len(pageItems))
+
+		if len(items)+len(pageItems) > maxItems {
+			kept := maxItems - len(items)
+			items = append(items, pageItems[:kept]...)
+			setPaging(style, merged, key, start, output, items, Page{Start: page.Start + kept}, true, true)
+			return merged, nil
+		}
+		items = append(items, pageItems...)
+
+		if !more || len(items) == maxItems || len(pageItems) == 0 {
+			setPaging(style, merged, key, start, output, items, next, more && len(pageItems) > 0, false)
+			return merged, nil
+		}
+		page = next
+	}
+}
+
+// itemsKey returns the key that holds the items of a page
+func itemsKey(output types.MapOutput) string {
+	for _, key := range itemKeys {
+		if _, ok := output[key].([]any); ok {
+			return key
+		}
+	}
+
+	// Fall back to the only array of the page
+	found := ""
+	for key, value := range output {
+		if _, ok := value.([]any); ok {
+			if found != "" {
+				return ""
+			}
+			found = key
+		}
+	}
+	return found
+}
+
+// nextPage returns the page after a page with n items and whether there is one.
+// Zero values such as isLastPage: false may have been pruned, so absent fields
+// are read as their zero values.
+func nextPage(style PagingStyle, page Page, output types.MapOutput, n int) (Page, bool) {
+	switch style {
+	case PagingNextStart:
+		if isLast, _ := output["isLastPage"].(bool); isLast {
+			return Page{}, false
+		}
+		next, ok := output["nextPageStart"].(float64)
+		return Page{Start: int(next)}, ok
+
+	case PagingLinks:
+		links, ok := output["_links"].(map[string]any)
+		if !ok {
+			// The links were pruned, a full page may be followed by another one
+			limit, _ := output["limit"].(float64)
+			return Page{Start: page.Start + n}, n > 0 && n >= int(limit)
+		}
+		link, _ := links["next"].(string)
+		if link == "" {
+			return Page{}, false
+		}
+		next := Page{Start: page.Start + n}
+		if u, err := url.Parse(link); err == nil {
+			next.Cursor = u.Query().Get("cursor")
+		}
+		return next, true
+
+	default:
+		if isLast, _ := output["isLast"].(bool); isLast {
+			return Page{}, false
+		}
+		next := Page{Start: page.Start + n}
+		if total, ok := output["total"].(float64); ok {
+			return next, next.Start < int(total)
+		}
+		if pageSize, ok := output["maxResults"].(float64); ok && n < int(pageSize) {
+			return next, false
+		}
+		return next, true
+	}
+}
+
+// setPaging updates the paging fields of the merged result for its items. When
+// more is set, the paging fields point at next. A page that was cut short to
+// maxItems cannot be continued with the cursor of the next page.
+func setPaging(style PagingStyle, merged types.MapOutput, key string, start Page, last types.MapOutput, items []any, next Page, more, cut bool) {
+	merged[key] = items
+	merged["truncated"] = more
+
+	switch style {
+	case PagingNextStart:
+		merged["size"] = len(items)
+		merged["isLastPage"] = !more
+		delete(merged, "nextPageStart")
+		if more {
+			merged["nextPageStart"] = next.Start
+		}
+
+	case PagingLinks:
+		merged["size"] = len(items)
+		links, _ := merged["_links"].(map[string]any)
+		lastLinks, _ := last["_links"].(map[string]any)
+		nextLink := lastLinks["next"]
+		if links != nil {
+			delete(links, "next")
+			if more && !cut && nextLink != nil {
+				links["next"] = nextLink
+			}
+		}
+
+	default:
+		merged["startAt"] = start.Start
+		merged["maxResults"] = len(items)
+		merged["isLast"] = !more
+	}
+}
@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// pagesOf serves 7 items in pages of 3 in the format of a paging style
+func pagesOf(t *testing.T, style PagingStyle, requested *[]Page) func(ctx context.Context, page Page) (types.MapOutput, error) {
+	const total, size = 7, 3
+	return func(ctx context.Context, page Page) (types.MapOutput, error) {
+		*requested = append(*requested, page)
+
+		start := page.Start
+		if page.Cursor != "" {
+			fmt.Sscanf(page.Cursor, "c%d", &start)
+		}
+		var items []string
+		for i := start; i < min(start+size, total); i++ {
+			items = append(items, fmt.Sprintf(`{"id":%d}`, i))
+		}
+		last := start+size >= total
+
+		var body string
+		switch style {
+		case PagingNextStart:
+			body = fmt.Sprintf(`{"size":%d,"limit":3,"start":%d,"values":[%s]`, len(items), start, strings.Join(items, ","))
+			if last {
+				body += `,"isLastPage":true}`
+			} else {
+				body += fmt.Sprintf(`,"nextPageStart":%d}`, start+size)
+			}
+		case PagingLinks:
+			body = fmt.Sprintf(`{"size":%d,"start":%d,"results":[%s],"_links":{"base":"http://wiki"`, len(items), start, strings.Join(items, ","))
+			if !last {
+				body += fmt.Sprintf(`,"next":"/rest/api/content/scan?cursor=c%d&limit=3"`, start+size)
+			}
+			body += `}}`
+		default:
+			body = fmt.Sprintf(`{"startAt":%d,"maxResults":3,"total":%d,"issues":[%s]}`, start, total, strings.Join(items, ","))
+		}
+
+		var output types.MapOutput
+		if err := json.Unmarshal([]byte(body), &output); err != nil {
+			t.Fatalf("invalid page %s: %v", body, err)
+		}
+		return output, nil
+	}
+}
+
+func TestFetchAll(t *testing.T) {
+	tests := []struct {
+		name      string
+		style     PagingStyle
+		maxItems  int
+		key       string
+		wantItems int
+		wantPages int
+		check     func(t *testing.T, merged types.MapOutput)
+	}{
+		{
+			name: "jira all pages", style: PagingOffset, key: "issues", wantItems: 7, wantPages: 3,
+			check: func(t *testing.T, merged types.MapOutput) {
+				if merged["truncated"] != false || merged["startAt"] != 0 || merged["maxResults"] != 7 {
+					t.Errorf("paging = %v", merged)
+				}
+			},
+		},
+		{
+			name: "jira truncated", style: PagingOffset, maxItems: 4, key: "issues", wantItems: 4, wantPages: 2,
+			check: func(t *testing.T, merged types.MapOutput) {
+				if merged["truncated"] != true || merged["maxResults"] != 4 || merged["total"] != float64(7) {
+					t.Errorf("paging = %v", merged)
+				}
+			},
+		},
+		{
+			name: "bitbucket all pages", style: PagingNextStart, key: "values", wantItems: 7, wantPages: 3,
+			check: func(t *testing.T, merged types.MapOutput) {
+				if merged["isLastPage"] != true || merged["nextPageStart"] != nil || merged["size"] != 7 {
+					t.Errorf("paging = %v", merged)
+				}
+			},
+		},
+		{
+			name: "bitbucket truncated within a page", style: PagingNextStart, maxItems: 5, key: "values", wantItems: 5, wantPages: 2,
+			check: func(t *testing.T, merged types.MapOutput) {
+				if merged["isLastPage"] != false || merged["nextPageStart"] != 5 || merged["truncated"] != true {
+					t.Errorf("paging = %v", merged)
+				}
+			},
+		},
+		{
+			name: "confluence cursor", style: PagingLinks, key: "results", wantItems: 7, wantPages: 3,
+			check: func(t *testing.T, merged types.MapOutput) {
+				if links := merged["_links"].(map[string]any); links["next"] != nil || links["base"] != "http://wiki" {
+					t.Errorf("links = %v", links)
+				}
+			},
+		},
+		{
+			name: "confluence truncated on a page boundary", style: PagingLinks, maxItems: 3, key: "results", wantItems: 3, wantPages: 1,
+			check: func(t *testing.T, merged types.MapOutput) {
+				if links := merged["_links"].(map[string]any); links["next"] != "/rest/api/content/scan?cursor=c3&limit=3" {
+					t.Errorf("links = %v", links)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested []Page
+			merged, err := FetchAll(context.Background(), tt.style, Page{}, tt.maxItems, pagesOf(t, tt.style, &requested))
+			if err != nil {
+				t.Fatalf("FetchAll failed: %v", err)
+			}
+
+			items := merged[tt.key].([]any)
+			if len(items) != tt.wantItems || len(requested) != tt.wantPages {
+				t.Fatalf("got %d items in %d pages, want %d items in %d pages", len(items), len(requested), tt.wantItems, tt.wantPages)
+			}
+			for i, item := range items {
+				if id := item.(map[string]any)["id"]; id != float64(i) {
+					t.Errorf("item %d has id %v", i, id)
+				}
+			}
+			tt.check(t, merged)
+		})
+	}
+}
+
+func TestFetchAllPrunedLinks(t *testing.T) {
+	// Confluence pages whose _links were removed by the prune rules
+	var requested []Page
+	merged, err := FetchAll(context.Background(), PagingLinks, Page{}, 0, func(ctx context.Context, page Page) (types.MapOutput, error) {
+		requested = append(requested, page)
+		items := []any{}
+		for i := page.Start; i < min(page.Start+3, 7); i++ {
+			items = append(items, map[string]any{"id": float64(i)})
+		}
+		return types.MapOutput{"start": float64(page.Start), "limit": float64(3), "size": float64(len(items)), "results": items}, nil
+	})
+	if err != nil {
+		t.Fatalf("FetchAll failed: %v", err)
+	}
+	if items := merged["results"].([]any); len(items) != 7 || len(requested) != 3 || merged["truncated"] != false {
+		t.Errorf("got %d items in %d pages, want 7 items in 3 pages", len(items), len(requested))
+	}
+}
+
+func TestFetchAllNotPaged(t *testing.T) {
+	calls := 0
+	merged, err := FetchAll(context.Background(), PagingOffset, Page{}, 0, func(ctx context.Context, page Page) (types.MapOutput, error) {
+		calls++
+		return types.MapOutput{"key": "ABC-1"}, nil
+	})
+	if err != nil || calls != 1 || merged["key"] != "ABC-1" {
+		t.Errorf("FetchAll = %v, %v after %d calls, want the single result", merged, err, calls)
+	}
+}
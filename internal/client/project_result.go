@@ -0,0 +1,111 @@
+package client
+
+import (
+	"strings"
+
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+// Project returns the fields of a decoded response that are selected by paths.
+// A path is a dot-separated list of keys, such as fields.status.name. Arrays are
+// projected element by element, so issues.key selects the key of every issue;
+// issues[].key and the gjson style issues.#.key are accepted as well. Paths that
+// do not exist in the response are ignored.
+func Project(m types.MapOutput, paths []string) types.MapOutput {
+	var projected any = map[string]any{}
+	for _, path := range paths {
+		keys := splitSelectPath(path)
+		if len(keys) == 0 {
+			continue
+		}
+		if value, ok := projectValue(map[string]any(m), keys); ok {
+			projected = mergeProjections(projected, value)
+		}
+	}
+
+	result, _ := projected.(map[string]any)
+	return result
+}
+
+// splitSelectPath splits a path into its keys, dropping array markers
+func splitSelectPath(path string) []string {
+	var keys []string
+	for _, key := range strings.Split(path, ".") {
+		key = strings.TrimSuffix(strings.TrimSpace(key), "[]")
+		if key == "" || key == "#" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// projectValue returns the part of value that is selected by keys
+func projectValue(value any, keys []string) (any, bool) {
+	if len(keys) == 0 {
+		return value, true
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		child, ok := v[keys[0]]
+		if !ok {
+			return nil, false
+		}
+		projected, ok := projectValue(child, keys[1:])
+		if !ok {
+			return nil, false
+		}
+		return map[string]any{keys[0]: projected}, true
+
+	case []any:
+		// Elements without the path become empty objects, so that the
+		// projections of several paths stay aligned
+		projected := make([]any, len(v))
+		found := false
+		for i, item := range v {
+			if p, ok := projectValue(item, keys); ok {
+				projected[i] = p
+				found = true
+			} else {
+				projected[i] = map[string]any{}
+			}
+		}
+		return projected, found
+
+	default:
+		return nil, false
+	}
+}
+
+// mergeProjections merges the projections of two paths of the same response
+func mergeProjections(a, b any) any {
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok {
+			return b
+		}
+		for k, v := range bv {
+			if existing, ok := av[k]; ok {
+				av[k] = mergeProjections(existing, v)
+			} else {
+				av[k] = v
+			}
+		}
+		return av
+
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return b
+		}
+		for i := range av {
+			av[i] = mergeProjections(av[i], bv[i])
+		}
+		return av
+
+	default:
+		return b
+	}
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"atlassian-dc-mcp-go/internal/types"
+)
+
+func TestProject(t *testing.T) {
+	const response = `{
+		"total": 2,
+		"issues": [
+			{"key": "ABC-1", "fields": {"summary": "First", "status": {"name": "Open", "id": "1"}, "labels": ["a"]}},
+			{"key": "ABC-2", "fields": {"summary": "Second"}}
+		]
+	}`
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  string
+	}{
+		{
+			name:  "nested fields of array elements",
+			paths: []string{"issues.key", "issues.fields.summary", "issues.fields.status.name"},
+			want:  `{"issues":[{"fields":{"status":{"name":"Open"},"summary":"First"},"key":"ABC-1"},{"fields":{"summary":"Second"},"key":"ABC-2"}]}`,
+		},
+		{
+			name:  "array markers",
+			paths: []string{"issues[].key", "issues.#.fields.labels", "total"},
+			want:  `{"issues":[{"fields":{"labels":["a"]},"key":"ABC-1"},{"key":"ABC-2"}],"total":2}`,
+		},
+		{
+			name:  "whole object and a path inside it",
+			paths: []string{"issues.fields.status", "issues.fields.status.name"},
+			want:  `{"issues":[{"fields":{"status":{"id":"1","name":"Open"}}},{}]}`,
+		},
+		{
+			name:  "missing paths",
+			paths: []string{"self", "issues.fields.assignee.name"},
+			want:  `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m types.MapOutput
+			if err := json.Unmarshal([]byte(response), &m); err != nil {
+				t.Fatal(err)
+			}
+
+			got, _ := json.Marshal(Project(m, tt.paths))
+			if string(got) != tt.want {
+				t.Errorf("Project(%v) = %s, want %s", tt.paths, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// filterBuffers holds the output buffers of pruneFilter. Buffers of very large
+// responses are not kept.
+var filterBuffers = sync.Pool{
+	New: func() any { return new([]byte) },
+}
+
+const maxPooledFilterBuffer = 4 << 20
+
+// pruneFilter removes pruned members from a JSON document in a single pass over
+// its tokens, without decoding it. Objects are written compactly; the values of
+// kept members other than objects and arrays are copied unchanged.
+type pruneFilter struct {
+	matcher *pruneMatcher
+	in      []byte
+	pos     int
+	out     []byte
+	path    [][]byte
+}
+
+// filterJSON returns the JSON document in with the members removed that the
+// matcher prunes, and empty values. It prunes the same members as Prune does
+// on the decoded document: objects at the top level, in objects and in arrays
+// are pruned, while arrays of arrays are copied unchanged. The returned buffer
+// must be released with releaseFilterBuffer once it has been decoded.
+func filterJSON(matcher *pruneMatcher, in []byte) ([]byte, error) {
+	buf := filterBuffers.Get().(*[]byte)
+	f := pruneFilter{matcher: matcher, in: in, out: (*buf)[:0], path: make([][]byte, 0, 16)}
+
+	f.skipSpace()
+	var err error
+	switch f.peek() {
+	case '{':
+		err = f.object()
+	case '[':
+		err = f.array()
+	default:
+		err = f.copyValue()
+	}
+	if err == nil {
+		if f.skipSpace(); f.pos != len(in) {
+			err = f.syntaxError("after top-level value")
+		}
+	}
+	if err != nil {
+		releaseFilterBuffer(f.out)
+		return nil, err
+	}
+	return f.out, nil
+}
+
+// releaseFilterBuffer returns a buffer of filterJSON to the pool
+func releaseFilterBuffer(buf []byte) {
+	if cap(buf) <= maxPooledFilterBuffer {
+		buf = buf[:0]
+		filterBuffers.Put(&buf)
+	}
+}
+
+// object filters the object at the current position
+func (f *pruneFilter) object() error {
+	f.pos++
+	f.out = append(f.out, '{')
+	f.skipSpace()
+	if f.peek() == '}' {
+		f.pos++
+		f.out = append(f.out, '}')
+		return nil
+	}
+
+	for kept := 0; ; {
+		f.skipSpace()
+		if f.peek() != '"' {
+			return f.syntaxError("looking for object key")
+		}
+
+		keyStart := f.pos
+		if err := f.skipString(); err != nil {
+			return err
+		}
+		rawKey := f.in[keyStart:f.pos]
+		key, err := unquoteKey(rawKey)
+		if err != nil {
+			return err
+		}
+
+		f.skipSpace()
+		if f.peek() != ':' {
+			return f.syntaxError("after object key")
+		}
+		f.pos++
+		f.skipSpace()
+
+		f.path = append(f.path, key)
+		if removes(f.matcher, f.path) || f.emptyValue() {
+			err = f.skipValue()
+		} else {
+			if kept > 0 {
+				f.out = append(f.out, ',')
+			}
+			kept++
+			f.out = append(f.out, rawKey...)
+			f.out = append(f.out, ':')
+			switch f.peek() {
+			case '{':
+				err = f.object()
+			case '[':
+				err = f.array()
+			default:
+				err = f.copyValue()
+			}
+		}
+		f.path = f.path[:len(f.path)-1]
+		if err != nil {
+			return err
+		}
+
+		f.skipSpace()
+		switch f.peek() {
+		case ',':
+			f.pos++
+		case '}':
+			f.pos++
+			f.out = append(f.out, '}')
+			return nil
+		default:
+			return f.syntaxError("after object value")
+		}
+	}
+}
+
+// array filters the objects of the array at the current position, which share
+// the path of the array
+func (f *pruneFilter) array() error {
+	f.pos++
+	f.out = append(f.out, '[')
+	f.skipSpace()
+	if f.peek() == ']' {
+		f.pos++
+		f.out = append(f.out, ']')
+		return nil
+	}
+
+	for n := 0; ; n++ {
+		if n > 0 {
+			f.out = append(f.out, ',')
+		}
+		f.skipSpace()
+		var err error
+		if f.peek() == '{' {
+			err = f.object()
+		} else {
+			err = f.copyValue()
+		}
+		if err != nil {
+			return err
+		}
+
+		f.skipSpace()
+		switch f.peek() {
+		case ',':
+			f.pos++
+		case ']':
+			f.pos++
+			f.out = append(f.out, ']')
+			return nil
+		default:
+			return f.syntaxError("after array element")
+		}
+	}
+}
+
+// copyValue copies the value at the current position unchanged
+func (f *pruneFilter) copyValue() error {
+	start := f.pos
+	if err := f.skipValue(); err != nil {
+		return err
+	}
+	f.out = append(f.out, f.in[start:f.pos]...)
+	return nil
+}
+
+// emptyValue reports whether the value at the current position is null, an
+// empty string, an empty object or an empty array
+func (f *pruneFilter) emptyValue() bool {
+	in := f.in[f.pos:]
+	if len(in) < 2 {
+		return false
+	}
+	switch in[0] {
+	case 'n':
+		return bytes.HasPrefix(in, []byte("null"))
+	case '"':
+		return in[1] == '"'
+	case '{', '[':
+		closing := byte('}')
+		if in[0] == '[' {
+			closing = ']'
+		}
+		for _, c := range in[1:] {
+			if !isSpace(c) {
+				return c == closing
+			}
+		}
+	}
+	return false
+}
+
+// skipValue moves past the value at the current position
+func (f *pruneFilter) skipValue() error {
+	switch c := f.peek(); c {
+	case '"':
+		return f.skipString()
+	case '{', '[':
+		depth := 0
+		for f.pos < len(f.in) {
+			switch f.in[f.pos] {
+			case '"':
+				if err := f.skipString(); err != nil {
+					return err
+				}
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					f.pos++
+					return nil
+				}
+			}
+			f.pos++
+		}
+		return f.syntaxError("in unterminated value")
+	default:
+		// Numbers, true, false and null
+		start := f.pos
+		for f.pos < len(f.in) && !isDelimiter(f.in[f.pos]) {
+			f.pos++
+		}
+		if f.pos == start {
+			return f.syntaxError("looking for value")
+		}
+		return nil
+	}
+}
+
+// skipString moves past the string at the current position
+func (f *pruneFilter) skipString() error {
+	for i := f.pos + 1; i < len(f.in); i++ {
+		switch f.in[i] {
+		case '\\':
+			i++
+		case '"':
+			f.pos = i + 1
+			return nil
+		}
+	}
+	return f.syntaxError("in unterminated string")
+}
+
+func (f *pruneFilter) skipSpace() {
+	for f.pos < len(f.in) && isSpace(f.in[f.pos]) {
+		f.pos++
+	}
+}
+
+// peek returns the byte at the current position, or 0 at the end of the input
+func (f *pruneFilter) peek() byte {
+	if f.pos < len(f.in) {
+		return f.in[f.pos]
+	}
+	return 0
+}
+
+func (f *pruneFilter) syntaxError(context string) error {
+	return fmt.Errorf("invalid JSON %s at offset %d", context, f.pos)
+}
+
+// unquoteKey returns the key of a quoted object key. Only keys with escape
+// sequences are decoded.
+func unquoteKey(raw []byte) ([]byte, error) {
+	key := raw[1 : len(raw)-1]
+	if bytes.IndexByte(key, '\\') < 0 {
+		return key, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isDelimiter(c byte) bool {
+	return c == ',' || c == '}' || c == ']' || isSpace(c)
+}
@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"atlassian-dc-mcp-go/internal/config"
+)
+
+var legacyArrayIndexRegex = regexp.MustCompile(`\[\d+\]`)
+
+// legacyPrune is the map-based pruning of previous releases. It is the reference
+// for the results of the filter and the baseline of the benchmarks.
+func legacyPrune(cfg *config.PruneConfig, m map[string]any, prefix string) {
+	for k, v := range m {
+		currentPath := k
+		if prefix != "" {
+			currentPath = prefix + "." + k
+		}
+
+		if legacyShouldRemove(cfg, currentPath) || isZeroValue(v) {
+			delete(m, k)
+			continue
+		}
+
+		switch vv := v.(type) {
+		case map[string]any:
+			legacyPrune(cfg, vv, currentPath)
+		case []any:
+			for i, item := range vv {
+				if itemMap, ok := item.(map[string]any); ok {
+					legacyPrune(cfg, itemMap, currentPath+fmt.Sprintf("[%d]", i))
+				}
+			}
+		}
+	}
+}
+
+func legacyShouldRemove(cfg *config.PruneConfig, path string) bool {
+	cleanPath := legacyArrayIndexRegex.ReplaceAllString(path, "")
+	for _, rp := range cfg.RemovePaths {
+		if strings.HasSuffix(cleanPath, "."+rp) || cleanPath == rp {
+			return true
+		}
+	}
+	keys := strings.Split(cleanPath, ".")
+	for _, fk := range cfg.FuzzyKeys {
+		if strings.HasPrefix(keys[len(keys)-1], fk) {
+			return true
+		}
+	}
+	return false
+}
+
+// searchResponse returns a Jira search response with n issues
+func searchResponse(n int) []byte {
+	user := func(name string) map[string]any {
+		return map[string]any{
+			"self": "https://jira/rest/api/2/user?username=" + name, "name": name, "key": "JIRAUSER" + name,
+			"emailAddress": name + "@example.com", "displayName": strings.ToUpper(name), "active": true, "timeZone": "Europe/Berlin",
+			"avatarUrls": map[string]any{"48x48": "https://jira/avatar?size=48", "16x16": "https://jira/avatar?size=16"},
+		}
+	}
+
+	issues := make([]any, n)
+	for i := range issues {
+		issues[i] = map[string]any{
+			"id": fmt.Sprint(10000 + i), "self": fmt.Sprintf("https://jira/rest/api/2/issue/%d", 10000+i), "key": fmt.Sprintf("ABC-%d", i),
+			"fields": map[string]any{
+				"summary": fmt.Sprintf("Issue %d with a \"quoted\" summary", i), "description": "", "environment": nil, "labels": []any{},
+				"customfield_10001": "Team A", "customfield_10002": nil, "customfield_10003": map[string]any{"value": "High", "id": "3"},
+				"assignee": user("alex"), "reporter": user("sam"), "creator": user("sam"),
+				"status": map[string]any{
+					"self": "https://jira/rest/api/2/status/3", "id": "3", "name": "In Progress", "description": "Work has started",
+					"iconUrl":        "https://jira/images/status.png",
+					"statusCategory": map[string]any{"id": 4, "key": "indeterminate", "colorName": "yellow", "name": "In Progress"},
+				},
+				"issuetype": map[string]any{"self": "https://jira/rest/api/2/issuetype/1", "id": "1", "name": "Bug", "subtask": false, "avatarId": 10303},
+				"priority":  map[string]any{"self": "https://jira/rest/api/2/priority/3", "id": "3", "name": "Major"},
+				"fixVersions": []any{
+					map[string]any{"self": "https://jira/rest/api/2/version/1", "id": "1", "name": "1.0", "released": false},
+				},
+				"votes":   map[string]any{"votes": 0, "hasVoted": false},
+				"watches": map[string]any{"watchCount": 1, "isWatching": true},
+				"comment": map[string]any{"total": 2, "comments": []any{
+					map[string]any{"id": "1", "body": "First", "author": user("alex"), "updateAuthor": user("alex")},
+					map[string]any{"id": "2", "body": "Second", "author": user("sam"), "updateAuthor": user("sam")},
+				}},
+				"created": "2025-01-01T12:00:00.000+0000", "workratio": -1, "timeestimate": 3600,
+			},
+		}
+	}
+
+	body, _ := json.Marshal(map[string]any{"expand": "schema,names", "startAt": 0, "maxResults": n, "total": n, "issues": issues})
+	return body
+}
+
+// activitiesResponse returns a page of n Bitbucket pull request activities
+func activitiesResponse(n int) []byte {
+	user := map[string]any{
+		"name": "alex", "emailAddress": "alex@example.com", "id": 101, "displayName": "Alex", "active": true, "slug": "alex", "type": "NORMAL",
+		"links": map[string]any{"self": []any{map[string]any{"href": "https://bitbucket/users/alex"}}},
+	}
+
+	values := make([]any, n)
+	for i := range values {
+		values[i] = map[string]any{
+			"id": i, "createdDate": 1700000000000 + i, "user": user, "action": "COMMENTED", "commentAction": "ADDED",
+			"comment": map[string]any{
+				"properties": map[string]any{"repositoryId": 1}, "id": i, "version": 0, "text": fmt.Sprintf("Comment\n%d", i),
+				"author": user, "createdDate": 1700000000000, "updatedDate": 1700000000000, "comments": []any{}, "tasks": []any{},
+				"severity": "NORMAL", "state": "OPEN", "threadResolved": false,
+				"permittedOperations": map[string]any{"editable": true, "transitionable": true, "deletable": true},
+			},
+			"commentAnchor": map[string]any{
+				"fromHash": "a1b2c3", "toHash": "d4e5f6", "line": 12, "lineType": "ADDED", "fileType": "TO", "diffType": "EFFECTIVE",
+				"path":     map[string]any{"components": []any{"src", "main.go"}, "parent": "src", "name": "main.go", "extension": "go", "toString": "src/main.go"},
+				"orphaned": false,
+			},
+		}
+	}
+
+	body, _ := json.Marshal(map[string]any{"size": n, "limit": n, "isLastPage": true, "values": values, "start": 0})
+	return body
+}
+
+func decodeFiltered(t testing.TB, matcher *pruneMatcher, body []byte) map[string]any {
+	filtered, err := filterJSON(matcher, body)
+	if err != nil {
+		t.Fatalf("filterJSON failed: %v", err)
+	}
+	defer releaseFilterBuffer(filtered)
+
+	var got map[string]any
+	if err := json.Unmarshal(filtered, &got); err != nil {
+		t.Fatalf("filtered JSON is invalid: %v\n%s", err, filtered)
+	}
+	return got
+}
+
+func TestFilterJSONMatchesLegacyPrune(t *testing.T) {
+	cfg := config.DefaultPruneConfig()
+	matcher := newPruneMatcher(config.PruneRules{FuzzyKeys: cfg.FuzzyKeys, RemovePaths: cfg.RemovePaths})
+
+	responses := map[string][]byte{
+		"search":     searchResponse(3),
+		"activities": activitiesResponse(3),
+		"edge cases": []byte(` { "a" : [ [ {"b": ""} ], {"c": null, "d": [ ], "e": { } , "f": "x\"}"}, 1.5e3, "s", true ] , ` +
+			`"ge": "" , "customfield_1": 1, "emailAddress": {"x": [1, {"y": 2}]}, "h": {"i": {}} } `),
+	}
+
+	for name, body := range responses {
+		t.Run(name, func(t *testing.T) {
+			var want map[string]any
+			if err := json.Unmarshal(body, &want); err != nil {
+				t.Fatal(err)
+			}
+			legacyPrune(&cfg, want, "")
+
+			if got := decodeFiltered(t, matcher, body); !reflect.DeepEqual(got, want) {
+				t.Errorf("filtered = %v\nwant %v", got, want)
+			}
+
+			var pruned map[string]any
+			if err := json.Unmarshal(body, &pruned); err != nil {
+				t.Fatal(err)
+			}
+			prune(matcher, pruned, nil)
+			if !reflect.DeepEqual(pruned, want) {
+				t.Errorf("pruned = %v\nwant %v", pruned, want)
+			}
+		})
+	}
+}
+
+func TestFilterJSONInvalid(t *testing.T) {
+	matcher := newPruneMatcher(config.PruneRules{})
+	for _, body := range []string{`{"a":1`, `{"a" 1}`, `{"a":1,}`, `[1 2]`, `{"a":"x}`, `{} {}`, `{"a":}`} {
+		if filtered, err := filterJSON(matcher, []byte(body)); err == nil {
+			t.Errorf("filterJSON(%s) = %s, want an error", body, filtered)
+		}
+	}
+}
+
+func TestDecodeResponsePrunes(t *testing.T) {
+	InitPruneConfig(config.PruneConfig{RemovePaths: []string{"avatarUrls"}})
+	defer InitPruneConfig(config.DefaultPruneConfig())
+
+	body := []byte(`{"name": "alex", "avatarUrls": {"16x16": "a.png"}, "groups": []}`)
+	c := &BaseClient{Name: "jira"}
+
+	var m map[string]any
+	if err := decodeResponse(context.Background(), c, body, &m); err != nil {
+		t.Fatalf("decodeResponse failed: %v", err)
+	}
+	if !reflect.DeepEqual(m, map[string]any{"name": "alex"}) {
+		t.Errorf("map = %v", m)
+	}
+
+	var raw map[string]any
+	if err := decodeResponse(WithPruneProfile(context.Background(), config.PruneProfileRaw), c, body, &raw); err != nil {
+		t.Fatalf("decodeResponse failed: %v", err)
+	}
+	if len(raw) != 3 {
+		t.Errorf("raw = %v, want all members", raw)
+	}
+
+	// Typed results are not pruned
+	var user struct {
+		AvatarURLs map[string]string `json:"avatarUrls"`
+	}
+	if err := decodeResponse(context.Background(), c, body, &user); err != nil || user.AvatarURLs["16x16"] != "a.png" {
+		t.Errorf("typed = %v, %v", user, err)
+	}
+
+	if err := decodeResponse(context.Background(), c, []byte(`{"name":`), &m); err == nil || !strings.Contains(err.Error(), "failed to decode response") {
+		t.Errorf("decodeResponse error = %v, want a decode error", err)
+	}
+}
+
+// BenchmarkPrune compares decoding and pruning a response with the legacy
+// map-based pruning, the trie-based Prune and the token filter.
+func BenchmarkPrune(b *testing.B) {
+	cfg := config.DefaultPruneConfig()
+	matcher := newPruneMatcher(config.PruneRules{FuzzyKeys: cfg.FuzzyKeys, RemovePaths: cfg.RemovePaths})
+
+	responses := []struct {
+		name string
+		body []byte
+	}{
+		{"search", searchResponse(200)},
+		{"activities", activitiesResponse(500)},
+	}
+
+	for _, r := range responses {
+		b.Run(r.name+"/legacy", func(b *testing.B) {
+			b.SetBytes(int64(len(r.body)))
+			b.ReportAllocs()
+			for b.Loop() {
+				var m map[string]any
+				if err := json.Unmarshal(r.body, &m); err != nil {
+					b.Fatal(err)
+				}
+				legacyPrune(&cfg, m, "")
+			}
+		})
+
+		b.Run(r.name+"/trie", func(b *testing.B) {
+			b.SetBytes(int64(len(r.body)))
+			b.ReportAllocs()
+			for b.Loop() {
+				var m map[string]any
+				if err := json.Unmarshal(r.body, &m); err != nil {
+					b.Fatal(err)
+				}
+				prune(matcher, m, make([]string, 0, 16))
+			}
+		})
+
+		b.Run(r.name+"/filter", func(b *testing.B) {
+			b.SetBytes(int64(len(r.body)))
+			b.ReportAllocs()
+			for b.Loop() {
+				decodeFiltered(b, matcher, r.body)
+			}
+		})
+	}
+}
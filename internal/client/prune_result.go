@@ -2,82 +2,186 @@
 package client
 
 import (
-	"fmt"
-	"regexp"
+	"context"
+	"maps"
+	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"atlassian-dc-mcp-go/internal/config"
 )
 
-var (
-	fuzzyKeys   []string
-	removePaths []string
-)
+// prunePolicy is the active prune configuration together with its compiled
+// profiles. It is replaced as a whole on reload, so a response is pruned with a
+// single consistent set of rules.
+type prunePolicy struct {
+	config   config.PruneConfig
+	matchers map[string]*pruneMatcher
+}
+
+var pruneConfig atomic.Pointer[prunePolicy]
+
+// pruneProfileKey is the context key of the prune profile of a call
+type pruneProfileKey struct{}
 
 // InitPruneConfig initializes the prune configuration from the config
 func InitPruneConfig(cfg config.PruneConfig) {
-	fuzzyKeys = cfg.FuzzyKeys
-	removePaths = cfg.RemovePaths
+	cfg.FuzzyKeys = append([]string(nil), cfg.FuzzyKeys...)
+	cfg.RemovePaths = append([]string(nil), cfg.RemovePaths...)
+	cfg.Profiles = maps.Clone(cfg.Profiles)
+	cfg.Services = maps.Clone(cfg.Services)
+	cfg.Tools = maps.Clone(cfg.Tools)
+
+	policy := &prunePolicy{config: cfg, matchers: make(map[string]*pruneMatcher)}
+	for _, name := range cfg.ProfileNames() {
+		rules, _ := cfg.Profile(name)
+		policy.matchers[strings.ToLower(name)] = newPruneMatcher(rules)
+	}
+	pruneConfig.Store(policy)
 }
 
-func Prune(m any) {
-	for {
-		switch v := m.(type) {
-		case *map[string]any:
-			if v == nil {
-				return
-			}
-			m = *v
-		case *[]any:
-			if v == nil {
-				return
-			}
-			m = *v
-		default:
-			goto done
+// WithPruneProfile returns a context whose responses are pruned with the named
+// profile instead of the profile of the service.
+func WithPruneProfile(ctx context.Context, profile string) context.Context {
+	return context.WithValue(ctx, pruneProfileKey{}, profile)
+}
+
+// PruneProfileExists reports whether a prune profile is built in or configured
+func PruneProfileExists(profile string) bool {
+	_, ok := pruneConfig.Load().config.Profile(profile)
+	return ok
+}
+
+// PruneProfileNames returns the names of the built-in and configured prune profiles
+func PruneProfileNames() []string {
+	return pruneConfig.Load().config.ProfileNames()
+}
+
+// ToolPruneProfile returns the prune profile configured for a tool, or an empty
+// string if the tool uses the profile of its service.
+func ToolPruneProfile(tool string) string {
+	return pruneConfig.Load().config.Tools[tool]
+}
+
+// pruneMatcherFor returns the compiled profile of the context, or else of the
+// profile configured for the service.
+func pruneMatcherFor(ctx context.Context, service string) *pruneMatcher {
+	policy := pruneConfig.Load()
+
+	profile, _ := ctx.Value(pruneProfileKey{}).(string)
+	if profile == "" {
+		profile = policy.config.Services[service]
+	}
+	if m, ok := policy.matchers[strings.ToLower(profile)]; ok {
+		return m
+	}
+	return policy.matchers[config.PruneProfileDefault]
+}
+
+// Prune removes the paths and keys of the prune profile of the context or the
+// service, and empty values, from a decoded response. It returns the approximate
+// number of JSON bytes it removed, measured on the removed values only so that
+// the response is not encoded again. Responses decoded by ExecuteRequest are
+// already pruned while they are decoded.
+func Prune(ctx context.Context, service string, m any) int {
+	matcher := pruneMatcherFor(ctx, service)
+	if matcher.disabled {
+		return 0
+	}
+
+	switch v := m.(type) {
+	case *map[string]any:
+		if v == nil {
+			return 0
 		}
+		m = *v
+	case *[]any:
+		if v == nil {
+			return 0
+		}
+		m = *v
 	}
-done:
+
+	path := make([]string, 0, 16)
+	saved := 0
 	switch m := m.(type) {
 	case map[string]any:
-		prune(m, "")
+		saved = prune(matcher, m, path)
 	case []any:
-		for i, v := range m {
+		for _, v := range m {
 			if v, ok := v.(map[string]any); ok {
-				prune(v, fmt.Sprintf("[%d]", i))
+				saved += prune(matcher, v, path)
 			}
 		}
 	}
+	return saved
 }
 
-func prune(m map[string]any, prefix string) {
+// prune prunes an object at a path of keys. Objects in arrays share the path of
+// their array.
+func prune(matcher *pruneMatcher, m map[string]any, path []string) int {
+	saved := 0
 	for k, v := range m {
-		currentPath := k
-		if prefix != "" {
-			currentPath = prefix + "." + k
-		}
-
-		if shouldRemove(currentPath) {
-			delete(m, k)
-			continue
-		}
+		currentPath := append(path, k)
 
-		if isZeroValue(v) {
+		if removes(matcher, currentPath) || isZeroValue(v) {
+			// The quoted key, the colon and the separating comma
+			saved += len(k) + 4 + jsonSize(v)
 			delete(m, k)
 			continue
 		}
 
 		switch vv := v.(type) {
 		case map[string]any:
-			prune(vv, currentPath)
+			saved += prune(matcher, vv, currentPath)
 		case []any:
-			for i, item := range vv {
+			for _, item := range vv {
 				if itemMap, ok := item.(map[string]any); ok {
-					prune(itemMap, currentPath+fmt.Sprintf("[%d]", i))
+					saved += prune(matcher, itemMap, currentPath)
 				}
 			}
 		}
 	}
+	return saved
+}
+
+// jsonSize returns the approximate size of the JSON encoding of a decoded value.
+// Escaped characters in strings are counted once.
+func jsonSize(v any) int {
+	switch vv := v.(type) {
+	case nil:
+		return 4
+	case bool:
+		if vv {
+			return 4
+		}
+		return 5
+	case string:
+		return len(vv) + 2
+	case float64:
+		var buf [32]byte
+		return len(strconv.AppendFloat(buf[:0], vv, 'g', -1, 64))
+	case map[string]any:
+		size := 2
+		for k, item := range vv {
+			size += len(k) + 4 + jsonSize(item)
+		}
+		if len(vv) > 0 {
+			size--
+		}
+		return size
+	case []any:
+		size := 2
+		for _, item := range vv {
+			size += jsonSize(item) + 1
+		}
+		if len(vv) > 0 {
+			size--
+		}
+		return size
+	default:
+		return 0
+	}
 }
 
 func isZeroValue(v any) bool {
@@ -94,32 +198,3 @@ func isZeroValue(v any) bool {
 		return false
 	}
 }
-
-func fuzzyMatch(key string) bool {
-	for _, fk := range fuzzyKeys {
-		if strings.HasPrefix(key, fk) {
-			return true
-		}
-	}
-	return false
-}
-
-func shouldRemove(path string) bool {
-	arrayIndexRegex := regexp.MustCompile(`\[\d+\]`)
-	cleanPath := arrayIndexRegex.ReplaceAllString(path, "")
-
-	for _, rp := range removePaths {
-		if strings.HasSuffix(cleanPath, "."+rp) || cleanPath == rp {
-			return true
-		}
-	}
-
-	keys := strings.Split(cleanPath, ".")
-	if len(keys) > 0 {
-		lastKey := keys[len(keys)-1]
-		if fuzzyMatch(lastKey) {
-			return true
-		}
-	}
-	return false
-}
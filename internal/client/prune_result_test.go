@@ -0,0 +1,79 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"atlassian-dc-mcp-go/internal/config"
+)
+
+const pruneTestResponse = `{
+	"key": "ABC-1",
+	"description": "",
+	"customfield_10001": "Team A",
+	"assignee": {"name": "alex", "emailAddress": "alex@example.com", "avatarUrls": {"16x16": "http://jira/a.png"}},
+	"path": {"components": ["docs"], "name": "README.md"}
+}`
+
+func TestPruneProfiles(t *testing.T) {
+	InitPruneConfig(config.PruneConfig{
+		FuzzyKeys:   []string{"customfield"},
+		RemovePaths: []string{"emailAddress", "avatarUrls", "path.components"},
+		Profiles: map[string]config.PruneRules{
+			"paths": {RemovePaths: []string{"path"}},
+		},
+		Services: map[string]string{"confluence": config.PruneProfileMinimal},
+	})
+	defer InitPruneConfig(config.DefaultPruneConfig())
+
+	tests := []struct {
+		name    string
+		service string
+		profile string
+		want    string
+	}{
+		{
+			name: "default", service: "jira",
+			want: `{"key":"ABC-1","assignee":{"name":"alex"},"path":{"name":"README.md"}}`,
+		},
+		{
+			name: "service profile", service: "confluence",
+			want: `{"key":"ABC-1","customfield_10001":"Team A","assignee":{"name":"alex"},"path":{"components":["docs"],"name":"README.md"}}`,
+		},
+		{
+			name: "call profile overrides service", service: "confluence", profile: "PATHS",
+			want: `{"key":"ABC-1","customfield_10001":"Team A","assignee":{"name":"alex","emailAddress":"alex@example.com","avatarUrls":{"16x16":"http://jira/a.png"}}}`,
+		},
+		{
+			name: "raw", service: "jira", profile: config.PruneProfileRaw,
+			want: pruneTestResponse,
+		},
+		{
+			name: "unknown profile falls back to default", service: "jira", profile: "missing",
+			want: `{"key":"ABC-1","assignee":{"name":"alex"},"path":{"name":"README.md"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.profile != "" {
+				ctx = WithPruneProfile(ctx, tt.profile)
+			}
+
+			var got, want map[string]any
+			if err := json.Unmarshal([]byte(pruneTestResponse), &got); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			Prune(ctx, tt.service, &got)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Prune = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"strings"
+
+	"atlassian-dc-mcp-go/internal/config"
+)
+
+// pruneMatcher is the compiled form of a prune profile. Its remove paths are
+// stored in a trie of keys that starts with the last key of each path, so that
+// the paths ending at a key are found by walking the keys of its path backwards.
+type pruneMatcher struct {
+	paths     *pathNode
+	fuzzyKeys []string
+	disabled  bool
+}
+
+// pathNode is a node of the trie of remove paths
+type pathNode struct {
+	children map[string]*pathNode
+	// end is set when the keys from the root to this node form a remove path
+	end bool
+}
+
+// newPruneMatcher compiles the rules of a prune profile
+func newPruneMatcher(rules config.PruneRules) *pruneMatcher {
+	m := &pruneMatcher{
+		paths:     &pathNode{},
+		fuzzyKeys: rules.FuzzyKeys,
+		disabled:  rules.Disabled,
+	}
+
+	for _, path := range rules.RemovePaths {
+		keys := strings.Split(path, ".")
+		node := m.paths
+		for i := len(keys) - 1; i >= 0; i-- {
+			if keys[i] == "" {
+				node = nil
+				break
+			}
+			child, ok := node.children[keys[i]]
+			if !ok {
+				if node.children == nil {
+					node.children = make(map[string]*pathNode)
+				}
+				child = &pathNode{}
+				node.children[keys[i]] = child
+			}
+			node = child
+		}
+		if node != nil && node != m.paths {
+			node.end = true
+		}
+	}
+	return m
+}
+
+// removes reports whether the value at a path of object keys is removed, either
+// because a remove path is a suffix of the path or because its last key starts
+// with a fuzzy key. Array indices are not part of the path.
+func removes[K string | []byte](m *pruneMatcher, path []K) bool {
+	if len(path) == 0 {
+		return false
+	}
+
+	key := path[len(path)-1]
+	for _, fuzzy := range m.fuzzyKeys {
+		if len(key) >= len(fuzzy) && string(key[:len(fuzzy)]) == fuzzy {
+			return true
+		}
+	}
+
+	node := m.paths
+	for i := len(path) - 1; i >= 0; i-- {
+		node = node.children[string(path[i])]
+		if node == nil {
+			return false
+		}
+		if node.end {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+
+	"atlassian-dc-mcp-go/internal/config"
+)
+
+// maxRetryAfter is the longest server-requested delay that is waited out by a retry.
+// Longer delays fail the request instead of blocking the tool call.
+const maxRetryAfter = time.Minute
+
+// ErrRateLimited is returned when the server asked clients to back off for longer than maxRetryAfter
+var ErrRateLimited = errors.New("rate limited by server")
+
+// RateLimiter is a token-bucket limiter for the requests sent to one Atlassian instance.
+// It also tracks the rate limit reported by the server, and holds back all requests
+// while the server asked clients to back off.
+type RateLimiter struct {
+	rate  float64
+	burst int
+
+	mu           sync.Mutex
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+	server       ServerRateLimit
+	throttled    int64
+}
+
+// ServerRateLimit is the rate limit state reported by the X-RateLimit-* headers
+type ServerRateLimit struct {
+	Limit           int     `json:"limit,omitempty"`
+	Remaining       int     `json:"remaining"`
+	FillRate        int     `json:"fillRate,omitempty"`
+	IntervalSeconds float64 `json:"intervalSeconds,omitempty"`
+}
+
+// RateLimitState is a snapshot of a RateLimiter
+type RateLimitState struct {
+	RequestsPerSecond float64          `json:"requestsPerSecond,omitempty"`
+	Burst             int              `json:"burst,omitempty"`
+	AvailableTokens   float64          `json:"availableTokens,omitempty"`
+	BlockedUntil      *time.Time       `json:"blockedUntil,omitempty"`
+	Server            *ServerRateLimit `json:"server,omitempty"`
+	Throttled         int64            `json:"throttled"`
+}
+
+// NewRateLimiter creates a limiter for the configuration. A zero rate disables
+// client-side limiting, but server back-off requests are still honored.
+func NewRateLimiter(cfg config.RateLimitConfig) *RateLimiter {
+	return &RateLimiter{
+		rate:   cfg.RequestsPerSecond,
+		burst:  cfg.Burst,
+		tokens: float64(cfg.Burst),
+		last:   time.Now(),
+		server: ServerRateLimit{Remaining: -1},
+	}
+}
+
+// Wait blocks until a request may be sent or the context is done.
+// It fails with ErrRateLimited instead of waiting out server back-offs that are
+// longer than maxRetryAfter or than the time left before the context deadline.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	// The timeout of the HTTP client runs while waiting, so a wait that would outlast
+	// the request deadline fails like a long back-off instead of timing out
+	deadline, ok := ctx.Deadline()
+	if delay > maxRetryAfter || (ok && time.Until(deadline) < delay) {
+		l.release()
+		return fmt.Errorf("%w, retry after %s", ErrRateLimited, delay.Round(time.Second))
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.release()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait for it
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	var delay time.Duration
+	if now.Before(l.blockedUntil) {
+		delay = l.blockedUntil.Sub(now)
+	}
+
+	if l.rate > 0 {
+		l.tokens = math.Min(float64(l.burst), l.tokens+now.Sub(l.last).Seconds()*l.rate)
+		l.last = now
+		l.tokens--
+		if l.tokens < 0 {
+			delay = max(delay, time.Duration(-l.tokens/l.rate*float64(time.Second)))
+		}
+	}
+
+	if delay > 0 {
+		l.throttled++
+	}
+	return delay
+}
+
+// release returns the token taken by reserve for a request that was not sent
+func (l *RateLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		l.tokens = math.Min(float64(l.burst), l.tokens+1)
+	}
+}
+
+// Observe records the rate limit headers of a response
+func (l *RateLimiter) Observe(resp *http.Response) {
+	server, ok := parseRateLimitHeaders(resp.Header)
+	wait, throttled := retryDelay(resp)
+
+	if !ok && !throttled {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if ok {
+		l.server = server
+	}
+	if throttled {
+		if until := time.Now().Add(wait); until.After(l.blockedUntil) {
+			l.blockedUntil = until
+		}
+	}
+}
+
+// State returns a snapshot of the limiter
+func (l *RateLimiter) State() RateLimitState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	state := RateLimitState{
+		RequestsPerSecond: l.rate,
+		Burst:             l.burst,
+		Throttled:         l.throttled,
+	}
+
+	if l.rate > 0 {
+		tokens := math.Min(float64(l.burst), l.tokens+time.Since(l.last).Seconds()*l.rate)
+		state.AvailableTokens = math.Max(0, math.Floor(tokens*100)/100)
+	}
+	if time.Now().Before(l.blockedUntil) {
+		until := l.blockedUntil
+		state.BlockedUntil = &until
+	}
+	if l.server.Remaining >= 0 {
+		server := l.server
+		state.Server = &server
+	}
+
+	return state
+}
+
+// parseRateLimitHeaders reads the X-RateLimit-* headers sent by Data Center instances
+// with rate limiting enabled
+func parseRateLimitHeaders(header http.Header) (ServerRateLimit, bool) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return ServerRateLimit{}, false
+	}
+
+	server := ServerRateLimit{Remaining: remaining}
+	server.Limit, _ = strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	server.FillRate, _ = strconv.Atoi(header.Get("X-RateLimit-FillRate"))
+	server.IntervalSeconds, _ = strconv.ParseFloat(header.Get("X-RateLimit-Interval-Seconds"), 64)
+	return server, true
+}
+
+// retryDelay returns how long the server asked clients to wait before the next request.
+// Retry-After takes precedence; otherwise the delay until the server refills one token
+// is derived from the X-RateLimit-* headers.
+func retryDelay(resp *http.Response) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+
+	if value := resp.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if date, err := http.ParseTime(value); err == nil {
+			return max(0, time.Until(date)), true
+		}
+	}
+
+	if server, ok := parseRateLimitHeaders(resp.Header); ok && server.Remaining <= 0 && server.FillRate > 0 && server.IntervalSeconds > 0 {
+		return time.Duration(server.IntervalSeconds / float64(server.FillRate) * float64(time.Second)), true
+	}
+
+	return 0, resp.StatusCode == http.StatusTooManyRequests
+}
+
+// rateLimitBackoff waits as long as the server asked for on 429 and 503 responses,
+// and uses exponential backoff otherwise
+func rateLimitBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if wait, ok := retryDelay(resp); ok && wait > 0 {
+		return wait
+	}
+	return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+}
+
+// rateLimitRetryPolicy does not retry when the server asks for a longer back-off
+// than maxRetryAfter, so that the tool call fails fast with the delay in the error
+func rateLimitRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if errors.Is(err, ErrRateLimited) {
+		return false, nil
+	}
+	if wait, ok := retryDelay(resp); ok && wait > maxRetryAfter {
+		return false, nil
+	}
+	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+}
+
+// rateLimitTransport applies a RateLimiter to every attempt of a request
+type rateLimitTransport struct {
+	limiter   *RateLimiter
+	transport http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if resp != nil {
+		t.limiter.Observe(resp)
+	}
+	return resp, err
+}
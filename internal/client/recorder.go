@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sync"
+)
+
+// RequestRecord describes a request sent to an Atlassian service
+type RequestRecord struct {
+	Service string
+	Method  string
+	Path    string
+	// StatusCode is 0 when no response was received
+	StatusCode int
+}
+
+// RequestRecorder collects the requests sent on behalf of a single tool call
+type RequestRecorder struct {
+	mu      sync.Mutex
+	records []RequestRecord
+}
+
+// recorderKey is the context key of the RequestRecorder
+type recorderKey struct{}
+
+// WithRequestRecorder returns a context that records the requests sent with it
+func WithRequestRecorder(ctx context.Context) (context.Context, *RequestRecorder) {
+	recorder := &RequestRecorder{}
+	return context.WithValue(ctx, recorderKey{}, recorder), recorder
+}
+
+// Records returns the recorded requests in the order they were sent
+func (r *RequestRecorder) Records() []RequestRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]RequestRecord(nil), r.records...)
+}
+
+// LastWrite returns the last recorded request that was not a GET request
+func (r *RequestRecorder) LastWrite() (RequestRecord, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := len(r.records) - 1; i >= 0; i-- {
+		if r.records[i].Method != http.MethodGet {
+			return r.records[i], true
+		}
+	}
+	return RequestRecord{}, false
+}
+
+// recordRequest adds a request to the recorder of the context, if any
+func recordRequest(ctx context.Context, client *BaseClient, req *http.Request, statusCode int) {
+	recorder, ok := ctx.Value(recorderKey{}).(*RequestRecorder)
+	if !ok {
+		return
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	recorder.records = append(recorder.records, RequestRecord{
+		Service:    client.Name,
+		Method:     req.Method,
+		Path:       resourcePath(client.Config.URL, req),
+		StatusCode: statusCode,
+	})
+}
+
+// TokenKeyForService returns the context key of the credentials of a service
+func TokenKeyForService(service string) (ContextKey, bool) {
+	switch service {
+	case "jira":
+		return JiraTokenKey, true
+	case "confluence":
+		return ConfluenceTokenKey, true
+	case "bitbucket":
+		return BitbucketTokenKey, true
+	default:
+		return "", false
+	}
+}
+
+// CredentialIdentity returns a short, stable hash of the credentials stored in the
+// context under key, or an empty string if there are none
+func CredentialIdentity(ctx context.Context, key ContextKey) string {
+	creds, ok := ctx.Value(key).(Credentials)
+	if !ok || creds.IsEmpty() {
+		return ""
+	}
+	return credentialHash(creds)
+}
+
+// credentialHash hashes credentials so that they can identify a caller without being exposed
+func credentialHash(creds Credentials) string {
+	sum := sha256.Sum256([]byte(creds.Authorization + "\x00" + creds.Cookie))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"atlassian-dc-mcp-go/internal/tracing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// requestTraceKey is the context key of the requestTrace of an ExecuteRequest or ExecuteStream call
+type requestTraceKey struct{}
+
+// requestTrace counts the attempts of a traced request. Attempts are sequential.
+type requestTrace struct {
+	attempts int
+}
+
+// startRequestSpan starts the span covering a request and all of its retries
+func startRequestSpan(ctx context.Context, client *BaseClient, req *http.Request) (context.Context, trace.Span, *requestTrace) {
+	ctx, span := tracing.Tracer().Start(ctx, client.Name+" "+req.Method,
+		trace.WithAttributes(
+			tracing.AttrService.String(client.Name),
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.path", req.URL.Path),
+		),
+	)
+
+	rt := &requestTrace{}
+	return context.WithValue(ctx, requestTraceKey{}, rt), span, rt
+}
+
+// endRequestSpan records the outcome of a request on its span and ends it.
+// A status code of 0 means that no response was received.
+func endRequestSpan(span trace.Span, rt *requestTrace, statusCode int, err error) {
+	span.SetAttributes(tracing.AttrRetryCount.Int(max(0, rt.attempts-1)))
+	if statusCode > 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", statusCode))
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracingTransport creates a client span for every attempt of a request and
+// propagates the trace context to the Atlassian service
+type tracingTransport struct {
+	service   string
+	transport http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attempt := 0
+	if rt, ok := req.Context().Value(requestTraceKey{}).(*requestTrace); ok {
+		attempt = rt.attempts
+		rt.attempts++
+	}
+
+	ctx, span := tracing.Tracer().Start(req.Context(), "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			tracing.AttrService.String(t.service),
+			tracing.AttrRetryCount.Int(attempt),
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("url.path", req.URL.Path),
+		),
+	)
+	defer span.End()
+
+	// Clone the request to avoid modifying the original request.
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	return resp, nil
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -34,6 +35,7 @@ type Accept string
 const (
 	AcceptJSON = Accept("application/json")
 	AcceptText = Accept("text/plain")
+	AcceptAny  = Accept("*/*")
 )
 
 func buildURL(baseURL string, pathSegments []any, queryParams map[string][]string) (string, error) {
@@ -83,6 +85,22 @@ func buildHttpRequest(method, baseURL string, pathSegments []any, queryParams ma
 	return req, nil
 }
 
+type headersKey struct{}
+
+// WithHeaders returns a context whose requests are sent with additional headers,
+// such as the Content-Type of a multipart body. They replace default headers of the same name.
+func WithHeaders(ctx context.Context, header http.Header) context.Context {
+	return context.WithValue(ctx, headersKey{}, header)
+}
+
+// setContextHeaders sets the headers added to the context with WithHeaders on a request
+func setContextHeaders(ctx context.Context, req *http.Request) {
+	header, _ := ctx.Value(headersKey{}).(http.Header)
+	for name, values := range header {
+		req.Header[http.CanonicalHeaderKey(name)] = values
+	}
+}
+
 func SetRequiredPathParam(queryParams url.Values, path string) {
 	if path == "" {
 		queryParams.Add("path", "/")
@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"math"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -21,12 +23,147 @@ type HTTPClientConfig struct {
 
 type Permissions map[string]bool
 
+// RateLimitConfig represents the client-side rate limit of a service
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained request rate; 0 disables client-side limiting
+	RequestsPerSecond float64 `mapstructure:"requests_per_second"`
+	// Burst is the number of requests that may be sent at once
+	Burst int `mapstructure:"burst"`
+}
+
+// validate sets rate limit defaults
+func (c *RateLimitConfig) validate(service string) error {
+	if c.RequestsPerSecond < 0 {
+		return fmt.Errorf("invalid %s rate_limit requests_per_second: %v, must not be negative", service, c.RequestsPerSecond)
+	}
+	if c.Burst <= 0 {
+		c.Burst = max(1, int(math.Ceil(c.RequestsPerSecond)))
+	}
+	return nil
+}
+
+// CacheConfig represents the response cache configuration of a service.
+// Only GET responses are cached.
+type CacheConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// TTL is the default time to live in seconds; 0 caches only the listed endpoints
+	TTL        int `mapstructure:"ttl"`
+	MaxEntries int `mapstructure:"max_entries"`
+	MaxSizeMB  int `mapstructure:"max_size_mb"`
+	// Endpoints override the TTL for request paths matching a glob pattern
+	Endpoints []CacheEndpointConfig `mapstructure:"endpoints"`
+}
+
+// CacheEndpointConfig sets the time to live of responses for a path pattern,
+// relative to the service URL, e.g. "rest/api/2/issue/*"
+type CacheEndpointConfig struct {
+	Path string `mapstructure:"path"`
+	TTL  int    `mapstructure:"ttl"`
+}
+
+// validate sets cache defaults and checks the endpoint patterns
+func (c *CacheConfig) validate(service string) error {
+	if c.MaxEntries <= 0 {
+		c.MaxEntries = 1000
+	}
+	if c.MaxSizeMB <= 0 {
+		c.MaxSizeMB = 32
+	}
+
+	for _, endpoint := range c.Endpoints {
+		if _, err := path.Match(endpoint.Path, ""); err != nil {
+			return fmt.Errorf("invalid %s cache endpoint: %q: %w", service, endpoint.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// Authentication types supported for connecting to a service
+const (
+	AuthTypeBearer = "bearer"
+	AuthTypeBasic  = "basic"
+	AuthTypeCookie = "cookie"
+)
+
 type ClientConfig struct {
-	URL         string         `mapstructure:"url"`
-	Token       string         `mapstructure:"token"`
-	Permissions Permissions    `mapstructure:"permissions"`
-	Timeout     int            `mapstructure:"timeout"`
+	URL   string `mapstructure:"url"`
+	Token string `mapstructure:"token"`
+	// AuthType selects the credentials sent to the service: bearer (default), basic or cookie
+	AuthType    string           `mapstructure:"auth_type"`
+	Username    string           `mapstructure:"username"`
+	Password    string           `mapstructure:"password"`
+	Cookie      string           `mapstructure:"cookie"`
+	Permissions Permissions      `mapstructure:"permissions"`
+	Timeout     int              `mapstructure:"timeout"`
 	HTTP        HTTPClientConfig `mapstructure:"http"`
+	Cache       CacheConfig      `mapstructure:"cache"`
+	RateLimit   RateLimitConfig  `mapstructure:"rate_limit"`
+	// AttachmentMaxSizeMB caps the size of attachments downloaded by the attachment tools
+	AttachmentMaxSizeMB int `mapstructure:"attachment_max_size_mb"`
+}
+
+// validateAuth checks the authentication settings of a service.
+// Credentials are only required when they are read from the configuration.
+func (c *ClientConfig) validateAuth(service, authMode string) error {
+	if c.AuthType == "" {
+		c.AuthType = AuthTypeBearer
+	}
+
+	switch c.AuthType {
+	case AuthTypeBearer, AuthTypeBasic, AuthTypeCookie:
+	default:
+		return fmt.Errorf("invalid %s auth type: %s, valid options are: bearer, basic, cookie", service, c.AuthType)
+	}
+
+	if authMode != "config" || c.URL == "" {
+		return nil
+	}
+
+	switch c.AuthType {
+	case AuthTypeBasic:
+		if c.Username == "" || c.Password == "" {
+			return fmt.Errorf("%s username and password must be set when %s auth type is basic", service, service)
+		}
+	case AuthTypeCookie:
+		if c.Cookie == "" {
+			return fmt.Errorf("%s cookie must be set when %s auth type is cookie", service, service)
+		}
+	default:
+		if c.Token == "" {
+			return fmt.Errorf("%s token must be set when %s url is configured", service, service)
+		}
+	}
+
+	return nil
+}
+
+// ToolsConfig represents the tool permission policy configuration
+type ToolsConfig struct {
+	// Rules are ordered glob patterns matched against tool names.
+	// A leading "!" denies matching tools; the last matching rule wins.
+	Rules []string `mapstructure:"rules"`
+}
+
+// OAuthConfig represents the OAuth 2.0 resource server configuration used with the oauth auth mode
+type OAuthConfig struct {
+	// Issuer is the expected token issuer. Its metadata is used to discover the JWKS if JWKSFile is not set.
+	Issuer string `mapstructure:"issuer"`
+	// JWKSFile is a local JSON Web Key Set used to verify token signatures
+	JWKSFile string `mapstructure:"jwks_file"`
+	// ResourceURL is the canonical URL of this MCP server, published in the protected resource metadata
+	ResourceURL string `mapstructure:"resource_url"`
+	// Audience is the expected aud claim, defaults to ResourceURL
+	Audience string `mapstructure:"audience"`
+	// Scopes are required in every token
+	Scopes []string `mapstructure:"scopes"`
+
+	TokenStore struct {
+		// Path is the encrypted file mapping token subjects to Atlassian tokens
+		Path string `mapstructure:"path"`
+		// KeyFile contains the base64 encoded 256-bit encryption key
+		KeyFile string `mapstructure:"key_file"`
+	} `mapstructure:"token_store"`
 }
 
 type TransportConfig struct {
@@ -54,6 +191,63 @@ type Config struct {
 	Transport     TransportConfig `mapstructure:"transport"`
 	ClientTimeout int             `mapstructure:"client_timeout"`
 	Prune         PruneConfig     `mapstructure:"prune"`
+	Tools         ToolsConfig     `mapstructure:"tools"`
+	OAuth         OAuthConfig     `mapstructure:"oauth"`
+	Tracing       TracingConfig   `mapstructure:"tracing"`
+	// DryRun makes every write tool return the requests it would send instead of executing them
+	DryRun bool `mapstructure:"dry_run"`
+}
+
+// Trace exporters supported by the tracing configuration
+const (
+	TracingExporterOTLPHTTP = "otlphttp"
+	TracingExporterFile     = "file"
+)
+
+// TracingConfig represents the OpenTelemetry tracing configuration
+type TracingConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Exporter is otlphttp (default) or file
+	Exporter string `mapstructure:"exporter"`
+	// Endpoint is the OTLP/HTTP traces URL, e.g. http://localhost:4318/v1/traces
+	Endpoint string            `mapstructure:"endpoint"`
+	Headers  map[string]string `mapstructure:"headers"`
+	// FilePath is the file that OTLP/JSON lines are appended to by the file exporter
+	FilePath    string  `mapstructure:"file_path"`
+	ServiceName string  `mapstructure:"service_name"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+// validate sets tracing defaults and checks the exporter settings
+func (c *TracingConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Exporter == "" {
+		c.Exporter = TracingExporterOTLPHTTP
+	}
+	if c.ServiceName == "" {
+		c.ServiceName = "atlassian-dc-mcp"
+	}
+	if c.SampleRatio <= 0 || c.SampleRatio > 1 {
+		c.SampleRatio = 1
+	}
+
+	switch c.Exporter {
+	case TracingExporterOTLPHTTP:
+		if c.Endpoint == "" {
+			return fmt.Errorf("tracing endpoint must be set when exporter is %s", c.Exporter)
+		}
+	case TracingExporterFile:
+		if c.FilePath == "" {
+			return fmt.Errorf("tracing file_path must be set when exporter is %s", c.Exporter)
+		}
+	default:
+		return fmt.Errorf("invalid tracing exporter: %s, valid options are: otlphttp, file", c.Exporter)
+	}
+
+	return nil
 }
 
 // Validate checks that the configuration is valid
@@ -129,25 +323,86 @@ func (c *Config) Validate(authMode string) error {
 		c.Bitbucket.HTTP.IdleConnTimeout = 90
 	}
 
-	// Set default prune config if not specified
+	if c.Jira.AttachmentMaxSizeMB <= 0 {
+		c.Jira.AttachmentMaxSizeMB = 10
+	}
+
+	if err := c.Jira.RateLimit.validate("jira"); err != nil {
+		return err
+	}
+	if err := c.Confluence.RateLimit.validate("confluence"); err != nil {
+		return err
+	}
+	if err := c.Bitbucket.RateLimit.validate("bitbucket"); err != nil {
+		return err
+	}
+
+	if err := c.Jira.Cache.validate("jira"); err != nil {
+		return err
+	}
+	if err := c.Confluence.Cache.validate("confluence"); err != nil {
+		return err
+	}
+	if err := c.Bitbucket.Cache.validate("bitbucket"); err != nil {
+		return err
+	}
+
+	if err := c.Tracing.validate(); err != nil {
+		return err
+	}
+
+	// Validate tool permission rules
+	for _, rule := range c.Tools.Rules {
+		pattern := strings.TrimPrefix(strings.TrimSpace(rule), "!")
+		if pattern == "" {
+			return fmt.Errorf("invalid tool rule: %q, pattern must not be empty", rule)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid tool rule: %q: %w", rule, err)
+		}
+	}
+
+	// Set default prune rules if not specified
 	if len(c.Prune.FuzzyKeys) == 0 && len(c.Prune.RemovePaths) == 0 {
-		c.Prune = DefaultPruneConfig()
+		defaultPrune := DefaultPruneConfig()
+		c.Prune.FuzzyKeys, c.Prune.RemovePaths = defaultPrune.FuzzyKeys, defaultPrune.RemovePaths
+	}
+	if err := c.Prune.Validate(); err != nil {
+		return err
+	}
+
+	switch authMode {
+	case "config", "header", "oauth":
+	default:
+		return fmt.Errorf("invalid auth mode: %s, valid options are: config, header, oauth", authMode)
 	}
 
-	if authMode != "header" {
-		if c.Jira.URL != "" && c.Jira.Token == "" {
-			return fmt.Errorf("jira token must be set when jira url is configured")
+	if authMode == "oauth" {
+		if c.OAuth.Issuer == "" && c.OAuth.JWKSFile == "" {
+			return fmt.Errorf("oauth issuer or jwks_file must be set when auth mode is oauth")
 		}
 
-		if c.Confluence.URL != "" && c.Confluence.Token == "" {
-			return fmt.Errorf("confluence token must be set when confluence url is configured")
+		if c.OAuth.ResourceURL == "" {
+			return fmt.Errorf("oauth resource_url must be set when auth mode is oauth")
 		}
 
-		if c.Bitbucket.URL != "" && c.Bitbucket.Token == "" {
-			return fmt.Errorf("bitbucket token must be set when bitbucket url is configured")
+		if c.OAuth.TokenStore.Path == "" || c.OAuth.TokenStore.KeyFile == "" {
+			return fmt.Errorf("oauth token_store path and key_file must be set when auth mode is oauth")
 		}
 	}
 
+	if err := c.Jira.validateAuth("jira", authMode); err != nil {
+		return err
+	}
+
+	if err := c.Confluence.validateAuth("confluence", authMode); err != nil {
+		return err
+	}
+
+	if err := c.Bitbucket.validateAuth("bitbucket", authMode); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -233,8 +488,9 @@ func LoadConfig(configPath string, authMode string) (*Config, error) {
 	return &config, nil
 }
 
-// WatchConfigOnChange sets up a callback for when the config file changes
-func WatchConfigOnChange(run func(), authMode string) {
+// WatchConfigOnChange sets up a callback for when the config file changes.
+// The callback receives the new configuration once it has been validated.
+func WatchConfigOnChange(run func(*Config), authMode string) {
 	viper.OnConfigChange(func(e fsnotify.Event) {
 		fmt.Println("Config file changed:", e.Name)
 
@@ -249,7 +505,7 @@ func WatchConfigOnChange(run func(), authMode string) {
 			return
 		}
 
-		run()
+		run(&newConfig)
 	})
 	viper.WatchConfig()
-}
\ No newline at end of file
+}
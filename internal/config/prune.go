@@ -1,5 +1,21 @@
 package config
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Built-in prune profiles
+const (
+	// PruneProfileDefault prunes with the top-level fuzzy_keys and remove_paths
+	PruneProfileDefault = "default"
+	// PruneProfileMinimal only removes empty values, avatars and email addresses
+	PruneProfileMinimal = "minimal"
+	// PruneProfileRaw leaves responses as they are
+	PruneProfileRaw = "raw"
+)
+
 // PruneConfig represents the configuration for pruning responses
 type PruneConfig struct {
 	// FuzzyKeys are prefixes for keys that should be removed
@@ -7,6 +23,80 @@ type PruneConfig struct {
 
 	// RemovePaths are exact paths or path suffixes that should be removed
 	RemovePaths []string `mapstructure:"remove_paths"`
+
+	// Profiles are named sets of prune rules. A profile named default replaces
+	// the top-level rules, and minimal and raw replace the built-in profiles.
+	Profiles map[string]PruneRules `mapstructure:"profiles"`
+
+	// Services maps a service (jira, confluence or bitbucket) to the profile of its responses
+	Services map[string]string `mapstructure:"services"`
+
+	// Tools maps a tool name to the profile of its responses, overriding the service profile
+	Tools map[string]string `mapstructure:"tools"`
+}
+
+// PruneRules are the rules of a prune profile
+type PruneRules struct {
+	// FuzzyKeys are prefixes for keys that should be removed
+	FuzzyKeys []string `mapstructure:"fuzzy_keys"`
+
+	// RemovePaths are exact paths or path suffixes that should be removed
+	RemovePaths []string `mapstructure:"remove_paths"`
+
+	// Disabled leaves responses as they are, including empty values
+	Disabled bool `mapstructure:"disabled"`
+}
+
+// Profile returns the rules of a prune profile. Profile names are case-insensitive
+// because the configuration loader lowercases map keys.
+func (c PruneConfig) Profile(name string) (PruneRules, bool) {
+	name = strings.ToLower(name)
+	if rules, ok := c.Profiles[name]; ok {
+		return rules, true
+	}
+
+	switch name {
+	case PruneProfileDefault:
+		return PruneRules{FuzzyKeys: c.FuzzyKeys, RemovePaths: c.RemovePaths}, true
+	case PruneProfileMinimal:
+		return PruneRules{RemovePaths: []string{"avatarUrls", "avatarId", "iconUrl", "thumbnail", "emailAddress"}}, true
+	case PruneProfileRaw:
+		return PruneRules{Disabled: true}, true
+	default:
+		return PruneRules{}, false
+	}
+}
+
+// ProfileNames returns the names of the built-in and configured prune profiles
+func (c PruneConfig) ProfileNames() []string {
+	names := []string{PruneProfileDefault, PruneProfileMinimal, PruneProfileRaw}
+	for name := range c.Profiles {
+		if name != PruneProfileDefault && name != PruneProfileMinimal && name != PruneProfileRaw {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names[3:])
+	return names
+}
+
+// Validate checks that the services and tools refer to existing prune profiles
+func (c PruneConfig) Validate() error {
+	for service, profile := range c.Services {
+		switch service {
+		case "jira", "confluence", "bitbucket":
+		default:
+			return fmt.Errorf("invalid prune service: %s, valid options are: jira, confluence, bitbucket", service)
+		}
+		if _, ok := c.Profile(profile); !ok {
+			return fmt.Errorf("unknown prune profile %q for service %s, valid options are: %s", profile, service, strings.Join(c.ProfileNames(), ", "))
+		}
+	}
+	for tool, profile := range c.Tools {
+		if _, ok := c.Profile(profile); !ok {
+			return fmt.Errorf("unknown prune profile %q for tool %s, valid options are: %s", profile, tool, strings.Join(c.ProfileNames(), ", "))
+		}
+	}
+	return nil
 }
 
 // DefaultPruneConfig returns the default prune configuration
@@ -86,8 +176,6 @@ func DefaultPruneConfig() PruneConfig {
 			"path.parent",
 			"workratio", //?
 			"type.id",
-			"type.inward",
-			"type.outward",
 			"type.self", //
 		},
 	}
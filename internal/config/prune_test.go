@@ -0,0 +1,24 @@
+package config
+
+import "testing"
+
+func TestPruneConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     PruneConfig
+		wantErr bool
+	}{
+		{name: "built-in profiles", cfg: PruneConfig{Services: map[string]string{"jira": "raw"}, Tools: map[string]string{"jira_get_issue": "Minimal"}}},
+		{name: "configured profile", cfg: PruneConfig{Profiles: map[string]PruneRules{"lean": {}}, Tools: map[string]string{"jira_get_issue": "lean"}}},
+		{name: "unknown service", cfg: PruneConfig{Services: map[string]string{"github": "raw"}}, wantErr: true},
+		{name: "unknown profile", cfg: PruneConfig{Tools: map[string]string{"jira_get_issue": "lean"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
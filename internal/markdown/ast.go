@@ -0,0 +1,97 @@
+// Package markdown parses and renders the subset of Markdown used to exchange
+// rich text with agents: headings, paragraphs, emphasis, links, images, lists,
+// task lists, block quotes, fenced code blocks and tables.
+//
+// The syntax tree is shared by the converters between Markdown and the markup
+// of the Atlassian services.
+package markdown
+
+// Kind is the type of a Node
+type Kind int
+
+// Block node kinds
+const (
+	Document Kind = iota
+	Paragraph
+	Heading
+	CodeBlock
+	BlockQuote
+	List
+	ListItem
+	Table
+	TableRow
+	TableCell
+	ThematicBreak
+)
+
+// Inline node kinds
+const (
+	Text Kind = iota + 100
+	Emphasis
+	Strong
+	Strikethrough
+	Code
+	Link
+	Image
+	LineBreak
+)
+
+// Align is the alignment of a table column
+type Align int
+
+// Table column alignments
+const (
+	AlignNone Align = iota
+	AlignLeft
+	AlignCenter
+	AlignRight
+)
+
+// Node is a node of the Markdown syntax tree
+type Node struct {
+	Kind     Kind
+	Children []*Node
+
+	// Text is the content of Text, Code and CodeBlock nodes and the alt text of images
+	Text string
+	// Level is the level of a heading, from 1 to 6
+	Level int
+	// Language is the info string of a fenced code block
+	Language string
+	// URL is the destination of a link or the source of an image
+	URL string
+
+	// Ordered is set for numbered lists
+	Ordered bool
+	// Tight is set for lists whose items are not separated by blank lines
+	Tight bool
+	// Task is set for task list items, Checked for completed tasks
+	Task    bool
+	Checked bool
+
+	// Header is set for the cells of the header row of a table
+	Header bool
+	// Align holds the alignment of the columns of a table
+	Align []Align
+}
+
+// IsInline reports whether the node is an inline node
+func (n *Node) IsInline() bool {
+	return n.Kind >= Text
+}
+
+// PlainText returns the text content of a node and its descendants
+func (n *Node) PlainText() string {
+	switch n.Kind {
+	case Text, Code, CodeBlock, Image:
+		return n.Text
+	case LineBreak:
+		return "\n"
+	}
+
+	var text string
+	for _, child := range n.Children {
+		text += child.PlainText()
+	}
+	return text
+}
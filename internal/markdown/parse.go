@@ -0,0 +1,679 @@
+package markdown
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	atxHeadingPattern     = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*))?$`)
+	thematicBreakPattern  = regexp.MustCompile(`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	fencePattern          = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \\t]*([^`]*)$")
+	listItemPattern       = regexp.MustCompile(`^( *)([-*+]|\d{1,9}[.)])([ \t]+|$)(.*)$`)
+	taskPattern           = regexp.MustCompile(`^\[([ xX])\](?:[ \t]+|$)`)
+	tableDelimiterPattern = regexp.MustCompile(`^ {0,3}\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	setextPattern         = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	lineBreakTagPattern   = regexp.MustCompile(`^<br\s*/?>`)
+)
+
+// Parse parses a Markdown document into its syntax tree
+func Parse(src string) *Node {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = strings.ReplaceAll(src, "\r", "\n")
+
+	lines := strings.Split(src, "\n")
+	for i, line := range lines {
+		lines[i] = expandTabs(line)
+	}
+
+	return &Node{Kind: Document, Children: parseBlocks(lines)}
+}
+
+// expandTabs replaces the tabs of the indentation of a line with four spaces
+func expandTabs(line string) string {
+	end := len(line) - len(strings.TrimLeft(line, " \t"))
+	if !strings.Contains(line[:end], "\t") {
+		return line
+	}
+	return strings.ReplaceAll(line[:end], "\t", "    ") + line[end:]
+}
+
+// indentation returns the number of leading spaces of a line
+func indentation(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// startsBlock reports whether a line interrupts a paragraph
+func startsBlock(line string) bool {
+	return isBlank(line) ||
+		fencePattern.MatchString(line) ||
+		atxHeadingPattern.MatchString(line) ||
+		thematicBreakPattern.MatchString(line) ||
+		isBlockQuote(line) ||
+		listItemPattern.MatchString(line)
+}
+
+func isBlockQuote(line string) bool {
+	return indentation(line) <= 3 && strings.HasPrefix(strings.TrimLeft(line, " "), ">")
+}
+
+// parseBlocks parses lines into block nodes
+func parseBlocks(lines []string) []*Node {
+	var blocks []*Node
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		var node *Node
+		n := 1
+
+		switch {
+		case isBlank(line):
+			i++
+			continue
+		case fencePattern.MatchString(line):
+			node, n = parseFencedCode(lines[i:])
+		case atxHeadingPattern.MatchString(line):
+			node = parseATXHeading(line)
+		case thematicBreakPattern.MatchString(line):
+			node = &Node{Kind: ThematicBreak}
+		case isBlockQuote(line):
+			node, n = parseBlockQuote(lines[i:])
+		case listItemPattern.MatchString(line):
+			node, n = parseList(lines[i:])
+		case indentation(line) >= 4:
+			node, n = parseIndentedCode(lines[i:])
+		case isTableStart(lines[i:]):
+			node, n = parseTable(lines[i:])
+		default:
+			node, n = parseParagraph(lines[i:])
+		}
+
+		blocks = append(blocks, node)
+		i += n
+	}
+	return blocks
+}
+
+// parseATXHeading parses a heading such as "## Title"
+func parseATXHeading(line string) *Node {
+	m := atxHeadingPattern.FindStringSubmatch(line)
+	text := strings.TrimSpace(m[2])
+
+	// Remove the optional closing sequence
+	if trimmed := strings.TrimRight(text, "#"); trimmed == "" || strings.HasSuffix(trimmed, " ") {
+		text = strings.TrimSpace(trimmed)
+	}
+
+	return &Node{Kind: Heading, Level: len(m[1]), Children: parseInline(text)}
+}
+
+// parseFencedCode parses a code block fenced with backticks or tildes
+func parseFencedCode(lines []string) (*Node, int) {
+	m := fencePattern.FindStringSubmatch(lines[0])
+	indent, fence := len(m[1]), m[2]
+
+	node := &Node{Kind: CodeBlock}
+	if fields := strings.Fields(m[3]); len(fields) > 0 {
+		node.Language = fields[0]
+	}
+
+	var content []string
+	i := 1
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimLeft(line, " ")
+		if indentation(line) <= 3 && strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]+" \t") == "" {
+			i++
+			break
+		}
+		content = append(content, line[min(indent, indentation(line)):])
+	}
+
+	node.Text = strings.Join(content, "\n")
+	return node, i
+}
+
+// parseIndentedCode parses a code block indented with four spaces
+func parseIndentedCode(lines []string) (*Node, int) {
+	var content []string
+	i := 0
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if !isBlank(line) && indentation(line) < 4 {
+			break
+		}
+		content = append(content, line[min(4, len(line)):])
+	}
+
+	for len(content) > 0 && isBlank(content[len(content)-1]) {
+		content = content[:len(content)-1]
+	}
+
+	return &Node{Kind: CodeBlock, Text: strings.Join(content, "\n")}, i
+}
+
+// parseBlockQuote parses consecutive lines starting with ">"
+func parseBlockQuote(lines []string) (*Node, int) {
+	var content []string
+	i := 0
+	for ; i < len(lines) && isBlockQuote(lines[i]); i++ {
+		line := strings.TrimPrefix(strings.TrimLeft(lines[i], " "), ">")
+		content = append(content, strings.TrimPrefix(line, " "))
+	}
+
+	return &Node{Kind: BlockQuote, Children: parseBlocks(content)}, i
+}
+
+// parseList parses a bullet or numbered list including nested blocks
+func parseList(lines []string) (*Node, int) {
+	first := listItemPattern.FindStringSubmatch(lines[0])
+	marker := first[2]
+	ordered := marker[0] >= '0' && marker[0] <= '9'
+	delimiter := marker[len(marker)-1]
+
+	list := &Node{Kind: List, Ordered: ordered, Tight: true}
+
+	// isItem reports whether a line starts an item of this list
+	isItem := func(line string) bool {
+		m := listItemPattern.FindStringSubmatch(line)
+		if m == nil || thematicBreakPattern.MatchString(line) {
+			return false
+		}
+		itemOrdered := m[2][0] >= '0' && m[2][0] <= '9'
+		return itemOrdered == ordered && m[2][len(m[2])-1] == delimiter
+	}
+
+	i := 0
+	for i < len(lines) && isItem(lines[i]) {
+		m := listItemPattern.FindStringSubmatch(lines[i])
+
+		// Content lines of the item are indented to the start of its text
+		offset := len(m[1]) + len(m[2]) + len(m[3])
+		if m[4] == "" || len(m[3]) > 4 {
+			offset = len(m[1]) + len(m[2]) + 1
+		}
+
+		content := []string{m[4]}
+		j := i + 1
+		for j < len(lines) {
+			line := lines[j]
+			if isBlank(line) {
+				k := j
+				for k < len(lines) && isBlank(lines[k]) {
+					k++
+				}
+				if k == len(lines) || indentation(lines[k]) < offset {
+					break
+				}
+				for ; j < k; j++ {
+					content = append(content, "")
+				}
+				continue
+			}
+			if indentation(line) >= offset {
+				content = append(content, line[offset:])
+				j++
+				continue
+			}
+			// Lazy continuation of the item's paragraph
+			if !startsBlock(line) && !isBlank(lines[j-1]) {
+				content = append(content, strings.TrimLeft(line, " "))
+				j++
+				continue
+			}
+			break
+		}
+
+		item := &Node{Kind: ListItem}
+		if task := taskPattern.FindStringSubmatch(content[0]); task != nil {
+			item.Task = true
+			item.Checked = task[1] != " "
+			content[0] = content[0][len(task[0]):]
+		}
+		item.Children = parseBlocks(content)
+		list.Children = append(list.Children, item)
+
+		// Blank lines between items make the list loose
+		k := j
+		for k < len(lines) && isBlank(lines[k]) {
+			k++
+		}
+		if k == len(lines) || !isItem(lines[k]) {
+			i = j
+			break
+		}
+		if k > j {
+			list.Tight = false
+		}
+		i = k
+	}
+
+	return list, i
+}
+
+// isTableStart reports whether the lines start with a table header and delimiter row
+func isTableStart(lines []string) bool {
+	if len(lines) < 2 || !strings.Contains(lines[0], "|") || !tableDelimiterPattern.MatchString(lines[1]) {
+		return false
+	}
+	return len(splitTableRow(lines[0])) == len(splitTableRow(lines[1]))
+}
+
+// parseTable parses a pipe table
+func parseTable(lines []string) (*Node, int) {
+	header := splitTableRow(lines[0])
+
+	table := &Node{Kind: Table}
+	for _, cell := range splitTableRow(lines[1]) {
+		cell = strings.TrimSpace(cell)
+		left, right := strings.HasPrefix(cell, ":"), strings.HasSuffix(cell, ":")
+		switch {
+		case left && right:
+			table.Align = append(table.Align, AlignCenter)
+		case left:
+			table.Align = append(table.Align, AlignLeft)
+		case right:
+			table.Align = append(table.Align, AlignRight)
+		default:
+			table.Align = append(table.Align, AlignNone)
+		}
+	}
+
+	table.Children = append(table.Children, tableRow(header, len(header), true))
+
+	i := 2
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if isBlank(line) || !strings.Contains(line, "|") || (startsBlock(line) && !strings.HasPrefix(strings.TrimSpace(line), "|")) {
+			break
+		}
+		table.Children = append(table.Children, tableRow(splitTableRow(line), len(header), false))
+	}
+
+	return table, i
+}
+
+// tableRow creates a row with the given number of columns
+func tableRow(cells []string, columns int, header bool) *Node {
+	row := &Node{Kind: TableRow}
+	for c := 0; c < columns; c++ {
+		cell := &Node{Kind: TableCell, Header: header}
+		if c < len(cells) {
+			cell.Children = parseInline(cells[c])
+		}
+		row.Children = append(row.Children, cell)
+	}
+	return row
+}
+
+// splitTableRow splits a table row into its cells at unescaped pipes
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// parseParagraph parses a paragraph or a setext heading
+func parseParagraph(lines []string) (*Node, int) {
+	var content []string
+	i := 0
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if i > 0 {
+			if m := setextPattern.FindStringSubmatch(line); m != nil {
+				level := 2
+				if m[1][0] == '=' {
+					level = 1
+				}
+				text := strings.TrimSpace(strings.Join(content, "\n"))
+				return &Node{Kind: Heading, Level: level, Children: parseInline(text)}, i + 1
+			}
+			if startsBlock(line) || isTableStart(lines[i:]) {
+				break
+			}
+		}
+		content = append(content, strings.TrimLeft(line, " "))
+	}
+
+	text := strings.TrimRight(strings.Join(content, "\n"), " \t")
+	return &Node{Kind: Paragraph, Children: parseInline(text)}, i
+}
+
+// parseInline parses the inline content of a block
+func parseInline(s string) []*Node {
+	var nodes []*Node
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, &Node{Kind: Text, Text: text.String()})
+			text.Reset()
+		}
+	}
+	appendNode := func(node *Node) {
+		flush()
+		nodes = append(nodes, node)
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		switch {
+		case c == '\\' && i+1 < len(s) && s[i+1] == '\n':
+			appendNode(&Node{Kind: LineBreak})
+			i += 2
+			continue
+		case c == '\\' && i+1 < len(s) && isPunctuation(s[i+1]):
+			text.WriteByte(s[i+1])
+			i += 2
+			continue
+		case c == '\n':
+			pending := text.String()
+			text.Reset()
+			text.WriteString(strings.TrimRight(pending, " "))
+			if strings.HasSuffix(pending, "  ") {
+				appendNode(&Node{Kind: LineBreak})
+			} else {
+				text.WriteByte('\n')
+			}
+			i++
+			for i < len(s) && s[i] == ' ' {
+				i++
+			}
+			continue
+		case c == '`':
+			if node, n := parseCodeSpan(s[i:]); node != nil {
+				appendNode(node)
+				i += n
+				continue
+			}
+			n := delimiterRun(s, i)
+			text.WriteString(s[i : i+n])
+			i += n
+			continue
+		case c == '!' && i+1 < len(s) && s[i+1] == '[':
+			if label, url, n, ok := parseLink(s[i+1:]); ok {
+				appendNode(&Node{Kind: Image, Text: inlinePlainText(label), URL: url})
+				i += 1 + n
+				continue
+			}
+		case c == '[':
+			if label, url, n, ok := parseLink(s[i:]); ok {
+				appendNode(&Node{Kind: Link, URL: url, Children: unlink(parseInline(label))})
+				i += n
+				continue
+			}
+		case c == '<':
+			if m := lineBreakTagPattern.FindString(s[i:]); m != "" {
+				appendNode(&Node{Kind: LineBreak})
+				i += len(m)
+				continue
+			}
+			if end := strings.IndexByte(s[i:], '>'); end > 0 {
+				if target := s[i+1 : i+end]; isAutolink(target) {
+					appendNode(&Node{Kind: Link, URL: target, Children: []*Node{{Kind: Text, Text: target}}})
+					i += end + 1
+					continue
+				}
+			}
+		case c == 'h' && (i == 0 || !isAlphanumeric(s[i-1])) && (strings.HasPrefix(s[i:], "http://") || strings.HasPrefix(s[i:], "https://")):
+			url := bareURL(s[i:])
+			appendNode(&Node{Kind: Link, URL: url, Children: []*Node{{Kind: Text, Text: url}}})
+			i += len(url)
+			continue
+		case c == '*' || c == '_' || c == '~':
+			if node, n := parseDelimited(s, i); node != nil {
+				appendNode(node)
+				i += n
+				continue
+			}
+			n := delimiterRun(s, i)
+			text.WriteString(s[i : i+n])
+			i += n
+			continue
+		}
+
+		text.WriteByte(c)
+		i++
+	}
+
+	flush()
+	return nodes
+}
+
+// unlink replaces the links nested in a link label, such as URLs, with their text
+func unlink(nodes []*Node) []*Node {
+	var result []*Node
+	for _, n := range nodes {
+		if n.Kind == Link {
+			result = append(result, unlink(n.Children)...)
+			continue
+		}
+		n.Children = unlink(n.Children)
+		result = append(result, n)
+	}
+	return result
+}
+
+// inlinePlainText returns the text of inline Markdown without its markup
+func inlinePlainText(s string) string {
+	return (&Node{Children: parseInline(s)}).PlainText()
+}
+
+func isPunctuation(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isAlphanumeric(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+// delimiterRun returns the length of the run of the character at position i
+func delimiterRun(s string, i int) int {
+	n := 1
+	for i+n < len(s) && s[i+n] == s[i] {
+		n++
+	}
+	return n
+}
+
+// isAutolink reports whether the content of angle brackets is a URL or e-mail link
+func isAutolink(s string) bool {
+	if strings.ContainsAny(s, " \t\n<") {
+		return false
+	}
+	return strings.Contains(s, "://") || strings.HasPrefix(s, "mailto:")
+}
+
+// bareURL returns the URL at the start of s, excluding trailing punctuation
+func bareURL(s string) string {
+	end := strings.IndexAny(s, " \t\n<")
+	if end < 0 {
+		end = len(s)
+	}
+	url := strings.TrimRight(s[:end], ".,;:!?*_~'\"")
+	if strings.HasSuffix(url, ")") && strings.Count(url, "(") < strings.Count(url, ")") {
+		url = url[:len(url)-1]
+	}
+	return url
+}
+
+// parseCodeSpan parses a code span starting at the beginning of s
+func parseCodeSpan(s string) (*Node, int) {
+	n := delimiterRun(s, 0)
+	for j := n; j < len(s); {
+		if s[j] != '`' {
+			j++
+			continue
+		}
+		run := delimiterRun(s, j)
+		if run == n {
+			content := strings.ReplaceAll(s[n:j], "\n", " ")
+			if len(content) > 1 && content[0] == ' ' && content[len(content)-1] == ' ' && strings.TrimSpace(content) != "" {
+				content = content[1 : len(content)-1]
+			}
+			return &Node{Kind: Code, Text: content}, j + run
+		}
+		j += run
+	}
+	return nil, 0
+}
+
+// parseLink parses "[label](url)" at the beginning of s
+func parseLink(s string) (label, url string, n int, ok bool) {
+	depth := 0
+	end := -1
+	for i := 0; i < len(s) && end < 0; i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				end = i
+			}
+		}
+	}
+	if end < 0 || end+1 >= len(s) || s[end+1] != '(' {
+		return "", "", 0, false
+	}
+	label = s[1:end]
+
+	i := end + 2
+	for i < len(s) && isSpace(s[i]) {
+		i++
+	}
+
+	if i < len(s) && s[i] == '<' {
+		close := strings.IndexByte(s[i:], '>')
+		if close < 0 {
+			return "", "", 0, false
+		}
+		url = s[i+1 : i+close]
+		i += close + 1
+	} else {
+		start, parens := i, 0
+		for ; i < len(s) && !isSpace(s[i]); i++ {
+			if s[i] == '(' {
+				parens++
+			} else if s[i] == ')' {
+				if parens == 0 {
+					break
+				}
+				parens--
+			}
+		}
+		url = s[start:i]
+	}
+
+	for i < len(s) && isSpace(s[i]) {
+		i++
+	}
+
+	// Skip the optional title
+	if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+		close := strings.IndexByte(s[i+1:], s[i])
+		if close < 0 {
+			return "", "", 0, false
+		}
+		i += close + 2
+		for i < len(s) && isSpace(s[i]) {
+			i++
+		}
+	}
+
+	if i >= len(s) || s[i] != ')' {
+		return "", "", 0, false
+	}
+	return label, url, i + 1, true
+}
+
+// parseDelimited parses emphasis, strong emphasis or strikethrough starting at position i
+func parseDelimited(s string, i int) (*Node, int) {
+	c := s[i]
+	run := delimiterRun(s, i)
+
+	// The opening delimiter must be followed by text, and underscores must not
+	// be inside a word, as in snake_case
+	if i+run >= len(s) || isSpace(s[i+run]) {
+		return nil, 0
+	}
+	if c == '_' && i > 0 && isAlphanumeric(s[i-1]) {
+		return nil, 0
+	}
+
+	switch {
+	case c == '~':
+		if run != 2 {
+			return nil, 0
+		}
+		if end := findClosing(s, i+2, c, 2); end >= 0 {
+			return &Node{Kind: Strikethrough, Children: parseInline(s[i+2 : end])}, end + 2 - i
+		}
+	case run >= 2:
+		if end := findClosing(s, i+2, c, 2); end >= 0 {
+			return &Node{Kind: Strong, Children: parseInline(s[i+2 : end])}, end + 2 - i
+		}
+		fallthrough
+	default:
+		if end := findClosing(s, i+1, c, 1); end >= 0 {
+			return &Node{Kind: Emphasis, Children: parseInline(s[i+1 : end])}, end + 1 - i
+		}
+	}
+	return nil, 0
+}
+
+// findClosing finds the closing delimiter of n characters c, starting the search at
+// position start. Code spans are skipped, and a closing delimiter at the end of a
+// longer run is preferred so that "***text***" nests correctly.
+func findClosing(s string, start int, c byte, n int) int {
+	for j := start + 1; j < len(s); {
+		switch s[j] {
+		case '`':
+			if _, m := parseCodeSpan(s[j:]); m > 0 {
+				j += m
+				continue
+			}
+		case '\\':
+			j += 2
+			continue
+		case c:
+			run := delimiterRun(s, j)
+			closing := j + run - n
+			if run >= n && !isSpace(s[closing-1]) && (run == n || run > 2) &&
+				(c != '_' || closing+n >= len(s) || !isAlphanumeric(s[closing+n])) {
+				return closing
+			}
+			j += run
+			continue
+		}
+		j++
+	}
+	return -1
+}
@@ -0,0 +1,295 @@
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// orderedMarkerPattern matches text that would start a numbered list
+var orderedMarkerPattern = regexp.MustCompile(`^(\d{1,9})([.)])`)
+
+// Render renders a syntax tree as Markdown
+func Render(doc *Node) string {
+	return strings.TrimRight(renderBlocks(doc.Children, false), "\n")
+}
+
+// renderBlocks renders block nodes separated by blank lines. In tight lists only
+// consecutive paragraphs are separated by a blank line.
+func renderBlocks(blocks []*Node, tight bool) string {
+	var b strings.Builder
+	var previous *Node
+	for _, block := range blocks {
+		rendered := renderBlock(block)
+		if rendered == "" {
+			continue
+		}
+		if previous != nil {
+			if tight && !(previous.Kind == Paragraph && block.Kind == Paragraph) {
+				b.WriteString("\n")
+			} else {
+				b.WriteString("\n\n")
+			}
+		}
+		b.WriteString(rendered)
+		previous = block
+	}
+	return b.String()
+}
+
+// renderBlock renders a single block node
+func renderBlock(n *Node) string {
+	switch n.Kind {
+	case Paragraph:
+		lines := strings.Split(strings.TrimSpace(renderInlines(n.Children)), "\n")
+		for i, line := range lines {
+			lines[i] = escapeLineStart(line)
+		}
+		return strings.Join(lines, "\n")
+	case Heading:
+		text := strings.ReplaceAll(strings.TrimSpace(renderInlines(n.Children)), "\\\n", " ")
+		return strings.Repeat("#", max(1, min(6, n.Level))) + " " + strings.ReplaceAll(text, "\n", " ")
+	case CodeBlock:
+		fence := "```"
+		for strings.Contains(n.Text, fence) {
+			fence += "`"
+		}
+		return fence + n.Language + "\n" + strings.TrimSuffix(n.Text, "\n") + "\n" + fence
+	case BlockQuote:
+		return prefixLines(renderBlocks(n.Children, false), "> ", ">")
+	case List:
+		return renderList(n)
+	case Table:
+		return renderTable(n)
+	case ThematicBreak:
+		return "---"
+	case Document, ListItem, TableRow, TableCell:
+		return renderBlocks(n.Children, false)
+	default:
+		return renderInlines([]*Node{n})
+	}
+}
+
+// renderList renders the items of a list with their markers
+func renderList(n *Node) string {
+	separator := "\n\n"
+	if n.Tight {
+		separator = "\n"
+	}
+
+	items := make([]string, 0, len(n.Children))
+	for i, item := range n.Children {
+		marker := "- "
+		if n.Ordered {
+			marker = fmt.Sprintf("%d. ", i+1)
+		}
+
+		content := renderBlocks(item.Children, n.Tight)
+		if item.Task {
+			box := "[ ] "
+			if item.Checked {
+				box = "[x] "
+			}
+			content = box + content
+		}
+
+		lines := strings.Split(content, "\n")
+		for j := range lines {
+			if j == 0 {
+				lines[j] = strings.TrimRight(marker+lines[j], " ")
+			} else if lines[j] != "" {
+				lines[j] = strings.Repeat(" ", len(marker)) + lines[j]
+			}
+		}
+		items = append(items, strings.Join(lines, "\n"))
+	}
+	return strings.Join(items, separator)
+}
+
+// renderTable renders a pipe table. The first row is used as the header row.
+func renderTable(n *Node) string {
+	columns := 0
+	for _, row := range n.Children {
+		columns = max(columns, len(row.Children))
+	}
+	if columns == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for r, row := range n.Children {
+		b.WriteString("|")
+		for c := 0; c < columns; c++ {
+			cell := ""
+			if c < len(row.Children) {
+				cell = renderTableCell(row.Children[c])
+			}
+			b.WriteString(" " + cell + " |")
+		}
+		b.WriteString("\n")
+
+		if r == 0 {
+			b.WriteString("|")
+			for c := 0; c < columns; c++ {
+				align := AlignNone
+				if c < len(n.Align) {
+					align = n.Align[c]
+				}
+				switch align {
+				case AlignLeft:
+					b.WriteString(" :--- |")
+				case AlignCenter:
+					b.WriteString(" :---: |")
+				case AlignRight:
+					b.WriteString(" ---: |")
+				default:
+					b.WriteString(" --- |")
+				}
+			}
+			b.WriteString("\n")
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// renderTableCell renders the content of a cell on a single line
+func renderTableCell(n *Node) string {
+	var text string
+	if len(n.Children) > 0 && !n.Children[0].IsInline() {
+		var parts []string
+		for _, block := range n.Children {
+			parts = append(parts, renderBlock(block))
+		}
+		text = strings.Join(parts, "<br>")
+	} else {
+		text = renderInlines(n.Children)
+	}
+
+	text = strings.ReplaceAll(text, "\\\n", "<br>")
+	text = strings.ReplaceAll(text, "\n", " ")
+	return strings.ReplaceAll(strings.TrimSpace(text), "|", `\|`)
+}
+
+// renderInlines renders inline nodes
+func renderInlines(nodes []*Node) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		switch n.Kind {
+		case Text:
+			b.WriteString(escapeText(n.Text))
+		case Emphasis:
+			b.WriteString(wrapInline(renderInlines(n.Children), "*"))
+		case Strong:
+			b.WriteString(wrapInline(renderInlines(n.Children), "**"))
+		case Strikethrough:
+			b.WriteString(wrapInline(renderInlines(n.Children), "~~"))
+		case Code:
+			b.WriteString(renderCodeSpan(n.Text))
+		case Link:
+			text := renderInlines(n.Children)
+			if isAutolink(n.URL) && (text == "" || n.PlainText() == n.URL) {
+				b.WriteString("<" + n.URL + ">")
+				continue
+			}
+			if text == "" {
+				text = escapeText(n.URL)
+			}
+			b.WriteString("[" + text + "](" + linkDestination(n.URL) + ")")
+		case Image:
+			b.WriteString("![" + escapeText(n.Text) + "](" + linkDestination(n.URL) + ")")
+		case LineBreak:
+			b.WriteString("\\\n")
+		default:
+			b.WriteString(renderBlock(n))
+		}
+	}
+	return b.String()
+}
+
+// wrapInline surrounds text with a delimiter, keeping surrounding spaces outside
+// of the delimiters so that the emphasis is recognized
+func wrapInline(text, delimiter string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	start := strings.Index(text, trimmed)
+	return text[:start] + delimiter + trimmed + delimiter + text[start+len(trimmed):]
+}
+
+// renderCodeSpan renders a code span with a fence longer than any backtick run in the code
+func renderCodeSpan(code string) string {
+	fence := "`"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(code, "`") || strings.HasSuffix(code, "`") {
+		code = " " + code + " "
+	}
+	return fence + code + fence
+}
+
+// linkDestination encloses destinations with spaces or unbalanced parentheses in angle brackets
+func linkDestination(url string) string {
+	if strings.ContainsAny(url, " <>") || strings.Count(url, "(") != strings.Count(url, ")") {
+		return "<" + strings.NewReplacer("<", "%3C", ">", "%3E").Replace(url) + ">"
+	}
+	return url
+}
+
+// escapeText escapes the characters of text that would be read as Markdown syntax
+func escapeText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '\\', '`', '*', '[', ']':
+			b.WriteByte('\\')
+		case '_':
+			// Underscores inside words, as in snake_case, are not emphasis
+			if i > 0 && i+1 < len(s) && isAlphanumeric(s[i-1]) && isAlphanumeric(s[i+1]) {
+				break
+			}
+			b.WriteByte('\\')
+		case '~':
+			if i+1 < len(s) && s[i+1] == '~' {
+				b.WriteByte('\\')
+			}
+		case '<':
+			if i+1 < len(s) && (isAlphanumeric(s[i+1]) || s[i+1] == '/' || s[i+1] == '!') {
+				b.WriteByte('\\')
+			}
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// escapeLineStart escapes characters at the start of a line of a paragraph that
+// would start another block, such as a heading or a list item
+func escapeLineStart(line string) string {
+	if line == "" {
+		return line
+	}
+	switch line[0] {
+	case '#', '>', '-', '+', '=', '|':
+		return "\\" + line
+	}
+	if m := orderedMarkerPattern.FindStringSubmatch(line); m != nil {
+		return m[1] + "\\" + line[len(m[1]):]
+	}
+	return line
+}
+
+// prefixLines prefixes every line of text, using blankPrefix for empty lines
+func prefixLines(text, prefix, blankPrefix string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = blankPrefix
+		} else {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,152 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"atlassian-dc-mcp-go/internal/auth"
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/config"
+	"atlassian-dc-mcp-go/internal/utils/logging"
+
+	mcpauth "github.com/modelcontextprotocol/go-sdk/auth"
+	"go.uber.org/zap"
+)
+
+// protectedResourceMetadataPath is the well-known path of the OAuth 2.0
+// protected resource metadata (RFC 9728)
+const protectedResourceMetadataPath = "/.well-known/oauth-protected-resource"
+
+// protectedResourceMetadata is the metadata document published for MCP clients
+type protectedResourceMetadata struct {
+	Resource               string   `json:"resource"`
+	AuthorizationServers   []string `json:"authorization_servers,omitempty"`
+	ScopesSupported        []string `json:"scopes_supported,omitempty"`
+	BearerMethodsSupported []string `json:"bearer_methods_supported"`
+	ResourceName           string   `json:"resource_name,omitempty"`
+}
+
+// oauthAuthenticator validates OAuth 2.0 bearer tokens on the HTTP transports and
+// maps the token subject to the user's Atlassian tokens from the token store.
+type oauthAuthenticator struct {
+	cfg      config.OAuthConfig
+	verifier *auth.Verifier
+	store    *auth.TokenStore
+}
+
+// newOAuthAuthenticator creates the authenticator for the oauth auth mode
+func newOAuthAuthenticator(cfg config.OAuthConfig) (*oauthAuthenticator, error) {
+	store, err := auth.NewTokenStore(cfg.TokenStore.Path, cfg.TokenStore.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open token store: %w", err)
+	}
+
+	return &oauthAuthenticator{
+		cfg:      cfg,
+		verifier: auth.NewVerifier(cfg),
+		store:    store,
+	}, nil
+}
+
+// metadataURL returns the URL of the protected resource metadata for the
+// configured resource, inserting the well-known path before the resource path.
+func (a *oauthAuthenticator) metadataURL() string {
+	u, err := url.Parse(a.cfg.ResourceURL)
+	if err != nil {
+		return ""
+	}
+	u.Path = protectedResourceMetadataPath + strings.TrimSuffix(u.Path, "/")
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
+
+// verifyToken adapts the JWT verifier to the MCP SDK token verifier interface
+func (a *oauthAuthenticator) verifyToken(ctx context.Context, token string, req *http.Request) (*mcpauth.TokenInfo, error) {
+	claims, err := a.verifier.Verify(ctx, token)
+	if err != nil {
+		logging.GetLogger().Debug("Bearer token rejected", zap.Error(err))
+		return nil, fmt.Errorf("%w: %v", mcpauth.ErrInvalidToken, err)
+	}
+
+	return &mcpauth.TokenInfo{
+		Scopes:     claims.Scopes,
+		Expiration: claims.ExpiresAt,
+		Extra: map[string]any{
+			"sub": claims.Subject,
+		},
+	}, nil
+}
+
+// wrap protects an MCP transport handler. Requests without a valid bearer token are
+// rejected with a WWW-Authenticate header that points to the resource metadata.
+func (a *oauthAuthenticator) wrap(next http.Handler) http.Handler {
+	requireToken := mcpauth.RequireBearerToken(a.verifyToken, &mcpauth.RequireBearerTokenOptions{
+		ResourceMetadataURL: a.metadataURL(),
+		Scopes:              a.cfg.Scopes,
+	})
+
+	return requireToken(a.injectCredentials(next))
+}
+
+// injectCredentials looks up the Atlassian tokens of the verified subject and
+// adds them to the request context
+func (a *oauthAuthenticator) injectCredentials(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info := mcpauth.TokenInfoFromContext(r.Context())
+		subject, _ := info.Extra["sub"].(string)
+
+		tokens, ok, err := a.store.Lookup(subject)
+		if err != nil {
+			logging.GetLogger().Error("Failed to read token store", zap.Error(err))
+			http.Error(w, "token store unavailable", http.StatusInternalServerError)
+			return
+		}
+		if !ok {
+			logging.GetLogger().Warn("No Atlassian tokens registered for subject", zap.String("subject", subject))
+			http.Error(w, "no Atlassian tokens registered for this user", http.StatusForbidden)
+			return
+		}
+
+		ctx := r.Context()
+		for key, token := range map[client.ContextKey]string{
+			client.JiraTokenKey:       tokens.Jira,
+			client.ConfluenceTokenKey: tokens.Confluence,
+			client.BitbucketTokenKey:  tokens.Bitbucket,
+		} {
+			if token != "" {
+				ctx = context.WithValue(ctx, key, client.BearerCredentials(token))
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// registerEndpoints serves the protected resource metadata at the well-known
+// path, both with and without the resource path appended
+func (a *oauthAuthenticator) registerEndpoints(mux *http.ServeMux) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		metadata := protectedResourceMetadata{
+			Resource:               a.cfg.ResourceURL,
+			ScopesSupported:        a.cfg.Scopes,
+			BearerMethodsSupported: []string{"header"},
+			ResourceName:           "Atlassian Data Center MCP Server",
+		}
+		if a.cfg.Issuer != "" {
+			metadata.AuthorizationServers = []string{a.cfg.Issuer}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(metadata)
+	}
+
+	mux.HandleFunc(protectedResourceMetadataPath, handler)
+	mux.HandleFunc(protectedResourceMetadataPath+"/", handler)
+}
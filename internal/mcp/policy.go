@@ -0,0 +1,96 @@
+package mcp
+
+import (
+	"path"
+	"strings"
+
+	"atlassian-dc-mcp-go/internal/config"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+)
+
+// toolRule is a single allow or deny glob pattern matched against tool names
+type toolRule struct {
+	raw     string
+	pattern string
+	deny    bool
+}
+
+// matches reports whether the rule pattern matches the given tool name
+func (r toolRule) matches(name string) bool {
+	matched, err := path.Match(r.pattern, name)
+	return err == nil && matched
+}
+
+// ToolPolicy decides which registered tools are exposed to MCP clients.
+//
+// Read-only tools are allowed and write tools are denied unless their legacy
+// permission key is enabled. The ordered glob rules are then applied on top of
+// that default: every rule that matches a tool name allows it, or denies it
+// when the rule starts with "!". The last matching rule wins.
+type ToolPolicy struct {
+	rules       []toolRule
+	permissions map[string]bool
+}
+
+// NewToolPolicy creates a ToolPolicy from the configured rules and the legacy
+// per-service permission maps.
+func NewToolPolicy(rules []string, permissions ...config.Permissions) *ToolPolicy {
+	policy := &ToolPolicy{
+		permissions: make(map[string]bool),
+	}
+
+	for _, raw := range rules {
+		rule := toolRule{raw: raw, pattern: strings.TrimSpace(raw)}
+		if strings.HasPrefix(rule.pattern, "!") {
+			rule.deny = true
+			rule.pattern = strings.TrimPrefix(rule.pattern, "!")
+		}
+		policy.rules = append(policy.rules, rule)
+	}
+
+	// Permission keys have historically been spelled with both dashes and
+	// underscores, so normalize them before lookup.
+	for _, perms := range permissions {
+		for key, enabled := range perms {
+			if enabled {
+				policy.permissions[strings.ReplaceAll(key, "-", "_")] = true
+			}
+		}
+	}
+
+	return policy
+}
+
+// Allowed reports whether the tool may be registered with the MCP server.
+func (p *ToolPolicy) Allowed(tool utils.ToolRegistration) bool {
+	allowed := tool.ReadOnly() || p.permissions[tool.Permission]
+
+	for _, rule := range p.rules {
+		if rule.matches(tool.Name) {
+			allowed = !rule.deny
+		}
+	}
+
+	return allowed
+}
+
+// UnmatchedRules returns the rules that do not match any of the given tools.
+func (p *ToolPolicy) UnmatchedRules(tools []utils.ToolRegistration) []string {
+	var unmatched []string
+
+	for _, rule := range p.rules {
+		found := false
+		for _, tool := range tools {
+			if rule.matches(tool.Name) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			unmatched = append(unmatched, rule.raw)
+		}
+	}
+
+	return unmatched
+}
@@ -0,0 +1,96 @@
+package mcp
+
+import (
+	"fmt"
+	"reflect"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/client/bitbucket"
+	"atlassian-dc-mcp-go/internal/client/confluence"
+	"atlassian-dc-mcp-go/internal/client/jira"
+	"atlassian-dc-mcp-go/internal/config"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+	"atlassian-dc-mcp-go/internal/utils/logging"
+
+	"go.uber.org/zap"
+)
+
+// serviceTools groups the tools collected for a single service
+type serviceTools struct {
+	service  string
+	registry *utils.ToolRegistry
+}
+
+// Reload applies a new configuration to the running server.
+//
+// Clients whose connection settings changed are rebuilt, the prune configuration
+// is re-initialized and the tool set of the MCP server is re-evaluated, so that
+// connected sessions receive a tools/list_changed notification. Port, transport
+// and logging settings only take effect after a restart.
+func (s *Server) Reload(cfg *config.Config) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jiraClient, confluenceClient, bitbucketClient := s.jiraClient, s.confluenceClient, s.bitbucketClient
+	rebuilt := make(map[string]bool)
+
+	// Build all new clients first so that a failure leaves the server untouched
+	if clientConfigChanged(s.config.Jira, cfg.Jira) {
+		jiraClient = nil
+		if cfg.Jira.URL != "" {
+			c, err := jira.NewJiraClient(&cfg.Jira)
+			if err != nil {
+				return fmt.Errorf("failed to create Jira client: %w", err)
+			}
+			jiraClient = c
+		}
+		rebuilt["jira"] = true
+	}
+
+	if clientConfigChanged(s.config.Confluence, cfg.Confluence) {
+		confluenceClient = nil
+		if cfg.Confluence.URL != "" {
+			c, err := confluence.NewConfluenceClient(&cfg.Confluence)
+			if err != nil {
+				return fmt.Errorf("failed to create Confluence client: %w", err)
+			}
+			confluenceClient = c
+		}
+		rebuilt["confluence"] = true
+	}
+
+	if clientConfigChanged(s.config.Bitbucket, cfg.Bitbucket) {
+		bitbucketClient = nil
+		if cfg.Bitbucket.URL != "" {
+			c, err := bitbucket.NewBitbucketClient(&cfg.Bitbucket)
+			if err != nil {
+				return fmt.Errorf("failed to create Bitbucket client: %w", err)
+			}
+			bitbucketClient = c
+		}
+		rebuilt["bitbucket"] = true
+	}
+
+	s.config = cfg
+	s.jiraClient, s.confluenceClient, s.bitbucketClient = jiraClient, confluenceClient, bitbucketClient
+
+	client.InitPruneConfig(cfg.Prune)
+	client.InitDryRun(cfg.DryRun)
+
+	s.applyTools(rebuilt)
+
+	logging.GetLogger().Info("Configuration applied",
+		zap.Bool("jira_rebuilt", rebuilt["jira"]),
+		zap.Bool("confluence_rebuilt", rebuilt["confluence"]),
+		zap.Bool("bitbucket_rebuilt", rebuilt["bitbucket"]),
+		zap.Int("active_tools", len(s.activeTools)))
+
+	return nil
+}
+
+// clientConfigChanged reports whether a client has to be rebuilt for the new settings.
+// Permission changes only affect tool registration and don't require a new client.
+func clientConfigChanged(oldCfg, newCfg config.ClientConfig) bool {
+	oldCfg.Permissions, newCfg.Permissions = nil, nil
+	return !reflect.DeepEqual(oldCfg, newCfg)
+}
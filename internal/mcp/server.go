@@ -22,6 +22,8 @@ import (
 	"atlassian-dc-mcp-go/internal/mcp/tools/common"
 	confluenceTools "atlassian-dc-mcp-go/internal/mcp/tools/confluence"
 	jiraTools "atlassian-dc-mcp-go/internal/mcp/tools/jira"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+	"atlassian-dc-mcp-go/internal/metrics"
 	"atlassian-dc-mcp-go/internal/utils/logging"
 
 	"go.uber.org/zap"
@@ -31,6 +33,14 @@ const (
 	BitbucketTokenHeader  = "Bitbucket-Token"
 	JiraTokenHeader       = "Jira-Token"
 	ConfluenceTokenHeader = "Confluence-Token"
+
+	BitbucketAuthorizationHeader  = "Bitbucket-Authorization"
+	JiraAuthorizationHeader       = "Jira-Authorization"
+	ConfluenceAuthorizationHeader = "Confluence-Authorization"
+
+	BitbucketCookieHeader  = "Bitbucket-Cookie"
+	JiraCookieHeader       = "Jira-Cookie"
+	ConfluenceCookieHeader = "Confluence-Cookie"
 )
 
 // Server represents the MCP server instance
@@ -44,6 +54,12 @@ type Server struct {
 	bitbucketClient  *bitbucket.BitbucketClient
 	mcpServer        *mcp.Server
 	httpServer       *http.Server
+	// oauth validates bearer tokens when running in oauth auth mode
+	oauth *oauthAuthenticator
+	// activeTools holds the names of the tools currently added to mcpServer
+	activeTools map[string]bool
+	// mu guards config, clients and activeTools against concurrent reloads
+	mu sync.RWMutex
 	// WaitGroup to manage goroutines
 	wg sync.WaitGroup
 	// Channel to signal shutdown
@@ -62,6 +78,7 @@ func NewServer(cfg *config.Config, authMode, version string) *Server {
 
 	// Initialize prune configuration
 	client.InitPruneConfig(cfg.Prune)
+	client.InitDryRun(cfg.DryRun)
 
 	return server
 }
@@ -90,16 +107,27 @@ func (s *Server) Initialize() error {
 		}
 	}
 
+	if s.authMode == "oauth" {
+		s.oauth, err = newOAuthAuthenticator(s.config.OAuth)
+		if err != nil {
+			return fmt.Errorf("failed to initialize OAuth authentication: %w", err)
+		}
+	}
+
 	s.mcpServer = mcp.NewServer(&mcp.Implementation{
 		Name:    "Atlassian Data Center MCP Server",
 		Version: s.version,
 	}, nil)
 
-	// Add middleware for logging and error handling
+	// Add middleware for logging, error handling and tracing.
+	// Middleware added later wraps the earlier ones.
 	s.mcpServer.AddReceivingMiddleware(LoggingMiddleware(&s.config.Logging))
 	s.mcpServer.AddReceivingMiddleware(ErrorMiddleware())
+	s.mcpServer.AddReceivingMiddleware(TracingMiddleware())
 
-	s.addTools()
+	s.mu.Lock()
+	s.applyTools(nil)
+	s.mu.Unlock()
 
 	return nil
 }
@@ -117,6 +145,11 @@ func (s *Server) Start(ctx context.Context) error {
 	// Register health and readiness check endpoints
 	s.registerHealthEndpoints(mux)
 
+	// Publish the protected resource metadata for OAuth clients
+	if s.oauth != nil {
+		s.oauth.registerEndpoints(mux)
+	}
+
 	// Initialize all requested transport modes
 	s.initTransports(ctx, mux, serverFactory)
 
@@ -171,92 +204,175 @@ func (s *Server) Stop(ctx context.Context) error {
 
 // GetConfig returns the server's configuration.
 func (s *Server) GetConfig() *config.Config {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.config
 }
 
 // GetJiraClient returns the Jira client instance.
 func (s *Server) GetJiraClient() *jira.JiraClient {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.jiraClient
 }
 
 // GetConfluenceClient returns the Confluence client instance.
 func (s *Server) GetConfluenceClient() *confluence.ConfluenceClient {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.confluenceClient
 }
 
 // GetBitbucketClient returns the Bitbucket client instance.
 func (s *Server) GetBitbucketClient() *bitbucket.BitbucketClient {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	return s.bitbucketClient
 }
 
-// addTools registers all available tools with the MCP server
-func (s *Server) addTools() {
-	common.AddHealthCheckTool(s.mcpServer, s)
-	common.AddCapabilitiesTool(s.mcpServer)
+// collectTools gathers the tools of every configured service into one registry
+// per service, in registration order.
+func (s *Server) collectTools() []serviceTools {
+	commonRegistry := utils.NewToolRegistry()
+	common.AddHealthCheckTool(commonRegistry, s)
+	common.AddCapabilitiesTool(commonRegistry)
+
+	collected := []serviceTools{{service: "common", registry: commonRegistry}}
 
 	if s.jiraClient != nil {
-		s.addJiraTools()
+		registry := utils.NewToolRegistry()
+		s.addJiraTools(registry)
+		collected = append(collected, serviceTools{service: "jira", registry: registry})
 	}
 
 	if s.confluenceClient != nil {
-		s.addConfluenceTools()
+		registry := utils.NewToolRegistry()
+		s.addConfluenceTools(registry)
+		collected = append(collected, serviceTools{service: "confluence", registry: registry})
 	}
 
 	if s.bitbucketClient != nil {
-		s.addBitbucketTools()
+		registry := utils.NewToolRegistry()
+		s.addBitbucketTools(registry)
+		collected = append(collected, serviceTools{service: "bitbucket", registry: registry})
 	}
+
+	return collected
 }
 
-// AuthMiddleware injects the authentication token into the request context
+// applyTools evaluates the permission policy against all collected tools and
+// synchronizes the MCP server with the result. Tools that are newly allowed or
+// belong to a rebuilt service are (re-)added, tools that are no longer allowed
+// are removed. The caller must hold s.mu.
+func (s *Server) applyTools(rebuilt map[string]bool) {
+	collected := s.collectTools()
+
+	var all []utils.ToolRegistration
+	for _, st := range collected {
+		all = append(all, st.registry.Tools()...)
+	}
+
+	policy := NewToolPolicy(s.config.Tools.Rules,
+		s.config.Jira.Permissions,
+		s.config.Confluence.Permissions,
+		s.config.Bitbucket.Permissions,
+	)
+
+	logger := logging.GetLogger()
+	for _, rule := range policy.UnmatchedRules(all) {
+		logger.Warn("Tool permission rule matches no tool", zap.String("rule", rule))
+	}
+
+	active := make(map[string]bool)
+	for _, st := range collected {
+		for _, tool := range st.registry.Tools() {
+			if !policy.Allowed(tool) {
+				logger.Debug("Tool disabled by permission policy", zap.String("tool", tool.Name))
+				continue
+			}
+
+			active[tool.Name] = true
+			if !s.activeTools[tool.Name] || rebuilt[st.service] {
+				tool.AddTo(s.mcpServer)
+			}
+		}
+	}
+
+	var removed []string
+	for name := range s.activeTools {
+		if !active[name] {
+			removed = append(removed, name)
+		}
+	}
+	if len(removed) > 0 {
+		s.mcpServer.RemoveTools(removed...)
+	}
+
+	s.activeTools = active
+}
+
+// AuthMiddleware injects the authentication credentials into the request context
 // based on the server's configured authentication mode.
+//
+// In oauth mode the credentials are injected after the bearer token has been
+// verified, see oauthAuthenticator.
+//
+// In header mode each service accepts a bearer token (e.g. Jira-Token), a complete
+// Authorization header value (e.g. Jira-Authorization) or a session cookie
+// (e.g. Jira-Cookie). The Authorization header takes precedence over the token.
 func (s *Server) AuthMiddleware(next http.Handler) http.Handler {
 	type serviceConfig struct {
-		headerKey string
-		cfgToken  func() string
-		ctxKey    client.ContextKey
-		url       *string
+		tokenHeader  string
+		authHeader   string
+		cookieHeader string
+		cfg          func() config.ClientConfig
+		ctxKey       client.ContextKey
 	}
 
 	services := []serviceConfig{
 		{
-			headerKey: BitbucketTokenHeader,
-			cfgToken:  func() string { return s.config.Bitbucket.Token },
-			ctxKey:    client.BitbucketTokenKey,
-			url:       &s.config.Bitbucket.URL,
+			tokenHeader:  BitbucketTokenHeader,
+			authHeader:   BitbucketAuthorizationHeader,
+			cookieHeader: BitbucketCookieHeader,
+			cfg:          func() config.ClientConfig { return s.GetConfig().Bitbucket },
+			ctxKey:       client.BitbucketTokenKey,
 		},
 		{
-			headerKey: JiraTokenHeader,
-			cfgToken:  func() string { return s.config.Jira.Token },
-			ctxKey:    client.JiraTokenKey,
-			url:       &s.config.Jira.URL,
+			tokenHeader:  JiraTokenHeader,
+			authHeader:   JiraAuthorizationHeader,
+			cookieHeader: JiraCookieHeader,
+			cfg:          func() config.ClientConfig { return s.GetConfig().Jira },
+			ctxKey:       client.JiraTokenKey,
 		},
 		{
-			headerKey: ConfluenceTokenHeader,
-			cfgToken:  func() string { return s.config.Confluence.Token },
-			ctxKey:    client.ConfluenceTokenKey,
-			url:       &s.config.Confluence.URL,
+			tokenHeader:  ConfluenceTokenHeader,
+			authHeader:   ConfluenceAuthorizationHeader,
+			cookieHeader: ConfluenceCookieHeader,
+			cfg:          func() config.ClientConfig { return s.GetConfig().Confluence },
+			ctxKey:       client.ConfluenceTokenKey,
 		},
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		tokenValues := make(map[client.ContextKey]string)
 
 		for _, svc := range services {
-			if s.authMode == "header" {
-				tokenValues[svc.ctxKey] = r.Header.Get(svc.headerKey)
+			var creds client.Credentials
+			switch s.authMode {
+			case "oauth":
 				continue
+			case "header":
+				creds = client.BearerCredentials(r.Header.Get(svc.tokenHeader))
+				if auth := r.Header.Get(svc.authHeader); auth != "" {
+					creds.Authorization = auth
+				}
+				creds.Cookie = r.Header.Get(svc.cookieHeader)
+			default:
+				creds = client.CredentialsFromConfig(svc.cfg())
 			}
 
-			token := svc.cfgToken()
-			if token != "" {
-				tokenValues[svc.ctxKey] = token
-			}
-		}
-
-		for key, token := range tokenValues {
-			if token != "" {
-				ctx = context.WithValue(ctx, key, token)
+			if !creds.IsEmpty() {
+				ctx = context.WithValue(ctx, svc.ctxKey, creds)
 			}
 		}
 
@@ -264,8 +380,11 @@ func (s *Server) AuthMiddleware(next http.Handler) http.Handler {
 	})
 }
 
-// registerHealthEndpoints registers health and readiness check endpoints
+// registerHealthEndpoints registers health, readiness and metrics endpoints
 func (s *Server) registerHealthEndpoints(mux *http.ServeMux) {
+	// Expose Prometheus metrics
+	mux.Handle("/metrics", metrics.Handler())
+
 	// Add a simple health check endpoint that doesn't require authentication
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -279,23 +398,24 @@ func (s *Server) registerHealthEndpoints(mux *http.ServeMux) {
 	// Add a readiness check endpoint that verifies service dependencies
 	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
-		
+
 		// Check if clients are initialized
 		readiness := map[string]interface{}{
 			"status": "ok",
 		}
 
 		issues := []string{}
+		cfg := s.GetConfig()
 
-		if s.config.Jira.URL != "" && s.jiraClient == nil {
+		if cfg.Jira.URL != "" && s.GetJiraClient() == nil {
 			issues = append(issues, "Jira client not initialized")
 		}
 
-		if s.config.Confluence.URL != "" && s.confluenceClient == nil {
+		if cfg.Confluence.URL != "" && s.GetConfluenceClient() == nil {
 			issues = append(issues, "Confluence client not initialized")
 		}
 
-		if s.config.Bitbucket.URL != "" && s.bitbucketClient == nil {
+		if cfg.Bitbucket.URL != "" && s.GetBitbucketClient() == nil {
 			issues = append(issues, "Bitbucket client not initialized")
 		}
 
@@ -336,18 +456,27 @@ func (s *Server) initTransports(ctx context.Context, mux *http.ServeMux, serverF
 			if ssePath == "" {
 				ssePath = "/sse"
 			}
-			mux.Handle(ssePath, sseHandler)
+			mux.Handle(ssePath, s.requireAuth(sseHandler))
 		case "http":
 			handler := mcp.NewStreamableHTTPHandler(serverFactory, nil)
 			httpPath := s.config.Transport.HTTP.Path
 			if httpPath == "" {
 				httpPath = "/mcp"
 			}
-			mux.Handle(httpPath, handler)
+			mux.Handle(httpPath, s.requireAuth(handler))
 		}
 	}
 }
 
+// requireAuth protects an MCP transport handler with OAuth bearer token
+// validation when the server runs in oauth auth mode
+func (s *Server) requireAuth(handler http.Handler) http.Handler {
+	if s.oauth == nil {
+		return handler
+	}
+	return s.oauth.wrap(handler)
+}
+
 // hasHTTPTransports checks if HTTP-based transports (http or sse) are configured
 func (s *Server) hasHTTPTransports() bool {
 	for _, t := range s.config.Transport.Modes {
@@ -384,46 +513,45 @@ func (s *Server) startHTTPServer(authMux http.Handler) {
 	}()
 }
 
-// addJiraTools registers all Jira-related tools with the MCP server
-func (s *Server) addJiraTools() {
-	// Check if Jira write permission is enabled
-	permissions := s.config.Jira.Permissions
-
-	jiraTools.AddIssueTools(s.mcpServer, s.jiraClient, permissions)
-	jiraTools.AddBoardTools(s.mcpServer, s.jiraClient, permissions)
-	jiraTools.AddProjectTools(s.mcpServer, s.jiraClient, permissions)
-	jiraTools.AddCommentTools(s.mcpServer, s.jiraClient, permissions)
-	jiraTools.AddIssueTypeTools(s.mcpServer, s.jiraClient, permissions)
-	jiraTools.AddPriorityTools(s.mcpServer, s.jiraClient, permissions)
-	jiraTools.AddTransitionTools(s.mcpServer, s.jiraClient, permissions)
-	jiraTools.AddUserTools(s.mcpServer, s.jiraClient, permissions)
-	jiraTools.AddWorklogTools(s.mcpServer, s.jiraClient, permissions)
-	jiraTools.AddSubtaskTools(s.mcpServer, s.jiraClient, permissions)
+// addJiraTools collects all Jira-related tools into the registry
+func (s *Server) addJiraTools(registry *utils.ToolRegistry) {
+	jiraTools.AddIssueTools(registry, s.jiraClient)
+	jiraTools.AddBoardTools(registry, s.jiraClient)
+	jiraTools.AddProjectTools(registry, s.jiraClient)
+	jiraTools.AddCommentTools(registry, s.jiraClient)
+	jiraTools.AddIssueTypeTools(registry, s.jiraClient)
+	jiraTools.AddPriorityTools(registry, s.jiraClient)
+	jiraTools.AddTransitionTools(registry, s.jiraClient)
+	jiraTools.AddUserTools(registry, s.jiraClient)
+	jiraTools.AddWorklogTools(registry, s.jiraClient)
+	jiraTools.AddSubtaskTools(registry, s.jiraClient)
+	jiraTools.AddLinkTools(registry, s.jiraClient)
+	jiraTools.AddAttachmentTools(registry, s.jiraClient)
+	jiraTools.AddVersionTools(registry, s.jiraClient)
+	jiraTools.AddComponentTools(registry, s.jiraClient)
+	jiraTools.AddFieldTools(registry, s.jiraClient)
+	jiraTools.AddWatcherTools(registry, s.jiraClient)
+	jiraTools.AddFilterTools(registry, s.jiraClient)
 }
 
-// addConfluenceTools registers all Confluence-related tools with the MCP server
-func (s *Server) addConfluenceTools() {
-	// Check if Confluence write permission is enabled
-	permissions := s.config.Confluence.Permissions
-
-	confluenceTools.AddContentTools(s.mcpServer, s.confluenceClient, permissions)
-	confluenceTools.AddSpaceTools(s.mcpServer, s.confluenceClient, permissions)
-	confluenceTools.AddChildrenTools(s.mcpServer, s.confluenceClient, permissions)
-	confluenceTools.AddLabelTools(s.mcpServer, s.confluenceClient, permissions)
-	confluenceTools.AddUserTools(s.mcpServer, s.confluenceClient, permissions)
+// addConfluenceTools collects all Confluence-related tools into the registry
+func (s *Server) addConfluenceTools(registry *utils.ToolRegistry) {
+	confluenceTools.AddContentTools(registry, s.confluenceClient)
+	confluenceTools.AddSpaceTools(registry, s.confluenceClient)
+	confluenceTools.AddChildrenTools(registry, s.confluenceClient)
+	confluenceTools.AddLabelTools(registry, s.confluenceClient)
+	confluenceTools.AddUserTools(registry, s.confluenceClient)
 }
 
-// addBitbucketTools registers all Bitbucket-related tools with the MCP server
-func (s *Server) addBitbucketTools() {
-	permissions := s.config.Bitbucket.Permissions
-
-	bitbucketTools.AddUserTools(s.mcpServer, s.bitbucketClient, permissions)
-	bitbucketTools.AddProjectTools(s.mcpServer, s.bitbucketClient, permissions)
-	bitbucketTools.AddBranchTools(s.mcpServer, s.bitbucketClient, permissions)
-	bitbucketTools.AddCommitTools(s.mcpServer, s.bitbucketClient, permissions)
-	bitbucketTools.AddPullRequestTools(s.mcpServer, s.bitbucketClient, permissions)
-	bitbucketTools.AddAttachmentTools(s.mcpServer, s.bitbucketClient, permissions)
-	bitbucketTools.AddTagTools(s.mcpServer, s.bitbucketClient, permissions)
-	bitbucketTools.AddRepositoryTools(s.mcpServer, s.bitbucketClient, permissions)
-	bitbucketTools.AddSearchTools(s.mcpServer, s.bitbucketClient, permissions)
+// addBitbucketTools collects all Bitbucket-related tools into the registry
+func (s *Server) addBitbucketTools(registry *utils.ToolRegistry) {
+	bitbucketTools.AddUserTools(registry, s.bitbucketClient)
+	bitbucketTools.AddProjectTools(registry, s.bitbucketClient)
+	bitbucketTools.AddBranchTools(registry, s.bitbucketClient)
+	bitbucketTools.AddCommitTools(registry, s.bitbucketClient)
+	bitbucketTools.AddPullRequestTools(registry, s.bitbucketClient)
+	bitbucketTools.AddAttachmentTools(registry, s.bitbucketClient)
+	bitbucketTools.AddTagTools(registry, s.bitbucketClient)
+	bitbucketTools.AddRepositoryTools(registry, s.bitbucketClient)
+	bitbucketTools.AddSearchTools(registry, s.bitbucketClient)
 }
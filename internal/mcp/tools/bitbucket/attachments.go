@@ -63,17 +63,13 @@ func (h *Handler) deleteAttachmentHandler(ctx context.Context, req *mcp.CallTool
 }
 
 // AddAttachmentTools registers the attachment-related tools with the MCP server
-func AddAttachmentTools(server *mcp.Server, client *bitbucket.BitbucketClient, permissions map[string]bool) {
+func AddAttachmentTools(registry *utils.ToolRegistry, client *bitbucket.BitbucketClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[bitbucket.GetAttachmentInput, GetAttachmentOutput](server, "bitbucket_get_attachment", "Get a specific attachment", handler.getAttachmentHandler)
-	utils.RegisterTool[bitbucket.GetAttachmentMetadataInput, types.MapOutput](server, "bitbucket_get_attachment_metadata", "Get metadata for a specific attachment", handler.getAttachmentMetadataHandler)
+	utils.RegisterTool[bitbucket.GetAttachmentInput, GetAttachmentOutput](registry, "bitbucket_get_attachment", "Get a specific attachment", handler.getAttachmentHandler)
+	utils.RegisterTool[bitbucket.GetAttachmentMetadataInput, types.MapOutput](registry, "bitbucket_get_attachment_metadata", "Get metadata for a specific attachment", handler.getAttachmentMetadataHandler)
 
-	if permissions["bitbucket_create_attachment"] {
-		utils.RegisterTool[bitbucket.CreateAttachmentInput, types.MapOutput](server, "bitbucket_create_attachment", "Create a new attachment", handler.createAttachmentHandler)
-	}
+	utils.RegisterWriteTool[bitbucket.CreateAttachmentInput, types.MapOutput](registry, "bitbucket_create_attachment", "bitbucket_create_attachment", "Create a new attachment", handler.createAttachmentHandler)
 
-	if permissions["bitbucket_delete_attachment"] {
-		utils.RegisterTool[bitbucket.DeleteAttachmentInput, interface{}](server, "bitbucket_delete_attachment", "Delete an attachment", handler.deleteAttachmentHandler)
-	}
+	utils.RegisterWriteTool[bitbucket.DeleteAttachmentInput, interface{}](registry, "bitbucket_delete_attachment", "bitbucket_delete_attachment", "Delete an attachment", handler.deleteAttachmentHandler)
 }
@@ -53,15 +53,12 @@ func (h *Handler) createBranchHandler(ctx context.Context, req *mcp.CallToolRequ
 }
 
 // AddBranchTools registers the branch-related tools with the MCP server
-func AddBranchTools(server *mcp.Server, client *bitbucket.BitbucketClient, permissions map[string]bool) {
+func AddBranchTools(registry *utils.ToolRegistry, client *bitbucket.BitbucketClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[bitbucket.GetBranchesInput, types.MapOutput](server, "bitbucket_get_branches", "Get branches for a repository", handler.getBranchesHandler)
-	utils.RegisterTool[bitbucket.GetDefaultBranchInput, types.MapOutput](server, "bitbucket_get_default_branch", "Get the default branch of a repository", handler.getDefaultBranchHandler)
-	utils.RegisterTool[bitbucket.GetBranchInput, types.MapOutput](server, "bitbucket_get_branch_info_by_commit_id", "Get branch information by commit ID", handler.getBranchHandler)
+	utils.RegisterTool[bitbucket.GetBranchesInput, types.MapOutput](registry, "bitbucket_get_branches", "Get branches for a repository", handler.getBranchesHandler)
+	utils.RegisterTool[bitbucket.GetDefaultBranchInput, types.MapOutput](registry, "bitbucket_get_default_branch", "Get the default branch of a repository", handler.getDefaultBranchHandler)
+	utils.RegisterTool[bitbucket.GetBranchInput, types.MapOutput](registry, "bitbucket_get_branch_info_by_commit_id", "Get branch information by commit ID", handler.getBranchHandler)
 
-	// Only register write operations if write permission is enabled
-	if permissions["bitbucket_create_branch"] {
-		utils.RegisterTool[bitbucket.CreateBranchInput, types.MapOutput](server, "bitbucket_create_branch", "Create a new branch in a repository", handler.createBranchHandler)
-	}
+	utils.RegisterWriteTool[bitbucket.CreateBranchInput, types.MapOutput](registry, "bitbucket_create_branch", "bitbucket_create_branch", "Create a new branch in a repository", handler.createBranchHandler)
 }
@@ -122,18 +122,18 @@ func (h *Handler) getDiffBetweenRevisionsForPathHandler(ctx context.Context, req
 }
 
 // AddCommitTools registers the commit-related tools with the MCP server
-func AddCommitTools(server *mcp.Server, client *bitbucket.BitbucketClient, permissions map[string]bool) {
+func AddCommitTools(registry *utils.ToolRegistry, client *bitbucket.BitbucketClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[bitbucket.GetCommitsInput, types.MapOutput](server, "bitbucket_get_commits", "Get commits for a repository", handler.getCommitsHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestCommitsInput, types.MapOutput](server, "bitbucket_get_pull_request_commits", "Get commits for a pull request", handler.getPullRequestCommitsHandler)
-	utils.RegisterTool[bitbucket.GetCommitInput, types.MapOutput](server, "bitbucket_get_commit", "Get a specific commit", handler.getCommitHandler)
-	utils.RegisterTool[bitbucket.GetCommitChangesInput, types.MapOutput](server, "bitbucket_get_commit_changes", "Get changes for a specific commit", handler.getCommitChangesHandler)
-	utils.RegisterTool[bitbucket.GetCommitCommentsInput, types.MapOutput](server, "bitbucket_get_commit_comments", "Get comments on a commit", handler.getCommitCommentsHandler)
-	utils.RegisterTool[bitbucket.GetCommitCommentInput, types.MapOutput](server, "bitbucket_get_commit_comment", "Get a specific comment on a commit", handler.getCommitCommentHandler)
-	utils.RegisterTool[bitbucket.GetCommitDiffStatsSummaryInput, types.MapOutput](server, "bitbucket_get_commit_diff_stats_summary", "Get diff statistics summary for a commit", handler.getCommitDiffStatsSummaryHandler)
-	utils.RegisterTool[bitbucket.GetDiffBetweenCommitsInput, DiffOutput](server, "bitbucket_get_diff_between_commits", "Get the diff between two commits", handler.getDiffBetweenCommitsHandler)
-	utils.RegisterTool[bitbucket.GetDiffBetweenRevisionsInput, DiffOutput](server, "bitbucket_get_diff_between_revisions", "Get the diff between revisions", handler.getDiffBetweenRevisionsHandler)
-	utils.RegisterTool[bitbucket.GetJiraIssueCommitsInput, types.MapOutput](server, "bitbucket_get_jira_issue_commits", "Get commits related to a Jira issue", handler.getJiraIssueCommitsHandler)
-	utils.RegisterTool[bitbucket.GetDiffBetweenRevisionsForPathInput, DiffOutput](server, "bitbucket_get_diff_between_revisions_for_path", "Get the diff between revisions for a specific path", handler.getDiffBetweenRevisionsForPathHandler)
+	utils.RegisterTool[bitbucket.GetCommitsInput, types.MapOutput](registry, "bitbucket_get_commits", "Get commits for a repository", handler.getCommitsHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestCommitsInput, types.MapOutput](registry, "bitbucket_get_pull_request_commits", "Get commits for a pull request", handler.getPullRequestCommitsHandler)
+	utils.RegisterTool[bitbucket.GetCommitInput, types.MapOutput](registry, "bitbucket_get_commit", "Get a specific commit", handler.getCommitHandler)
+	utils.RegisterTool[bitbucket.GetCommitChangesInput, types.MapOutput](registry, "bitbucket_get_commit_changes", "Get changes for a specific commit", handler.getCommitChangesHandler)
+	utils.RegisterTool[bitbucket.GetCommitCommentsInput, types.MapOutput](registry, "bitbucket_get_commit_comments", "Get comments on a commit", handler.getCommitCommentsHandler)
+	utils.RegisterTool[bitbucket.GetCommitCommentInput, types.MapOutput](registry, "bitbucket_get_commit_comment", "Get a specific comment on a commit", handler.getCommitCommentHandler)
+	utils.RegisterTool[bitbucket.GetCommitDiffStatsSummaryInput, types.MapOutput](registry, "bitbucket_get_commit_diff_stats_summary", "Get diff statistics summary for a commit", handler.getCommitDiffStatsSummaryHandler)
+	utils.RegisterTool[bitbucket.GetDiffBetweenCommitsInput, DiffOutput](registry, "bitbucket_get_diff_between_commits", "Get the diff between two commits", handler.getDiffBetweenCommitsHandler)
+	utils.RegisterTool[bitbucket.GetDiffBetweenRevisionsInput, DiffOutput](registry, "bitbucket_get_diff_between_revisions", "Get the diff between revisions", handler.getDiffBetweenRevisionsHandler)
+	utils.RegisterTool[bitbucket.GetJiraIssueCommitsInput, types.MapOutput](registry, "bitbucket_get_jira_issue_commits", "Get commits related to a Jira issue", handler.getJiraIssueCommitsHandler)
+	utils.RegisterTool[bitbucket.GetDiffBetweenRevisionsForPathInput, DiffOutput](registry, "bitbucket_get_diff_between_revisions_for_path", "Get the diff between revisions for a specific path", handler.getDiffBetweenRevisionsForPathHandler)
 }
@@ -62,12 +62,12 @@ func (h *Handler) getRepositoryTasksHandler(ctx context.Context, req *mcp.CallTo
 }
 
 // AddProjectTools registers the project-related tools with the MCP server
-func AddProjectTools(server *mcp.Server, client *bitbucket.BitbucketClient, permissions map[string]bool) {
+func AddProjectTools(registry *utils.ToolRegistry, client *bitbucket.BitbucketClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[bitbucket.GetProjectsInput, types.MapOutput](server, "bitbucket_get_projects", "Get a list of projects", handler.getProjectsHandler)
-	utils.RegisterTool[bitbucket.GetProjectInput, types.MapOutput](server, "bitbucket_get_project", "Get a specific project by project key", handler.getProjectHandler)
-	utils.RegisterTool[bitbucket.GetProjectPrimaryEnhancedEntityLinkInput, types.MapOutput](server, "bitbucket_get_project_primary_enhanced_entity_link", "Get project's primary enhanced entity link", handler.getProjectPrimaryEnhancedEntityLinkHandler)
-	utils.RegisterTool[bitbucket.GetProjectTasksInput, types.MapOutput](server, "bitbucket_get_project_tasks", "Get tasks for a specific project", handler.getProjectTasksHandler)
-	utils.RegisterTool[bitbucket.GetRepositoryTasksInput, types.MapOutput](server, "bitbucket_get_repository_tasks", "Get tasks for a specific repository", handler.getRepositoryTasksHandler)
+	utils.RegisterTool[bitbucket.GetProjectsInput, types.MapOutput](registry, "bitbucket_get_projects", "Get a list of projects", handler.getProjectsHandler)
+	utils.RegisterTool[bitbucket.GetProjectInput, types.MapOutput](registry, "bitbucket_get_project", "Get a specific project by project key", handler.getProjectHandler)
+	utils.RegisterTool[bitbucket.GetProjectPrimaryEnhancedEntityLinkInput, types.MapOutput](registry, "bitbucket_get_project_primary_enhanced_entity_link", "Get project's primary enhanced entity link", handler.getProjectPrimaryEnhancedEntityLinkHandler)
+	utils.RegisterTool[bitbucket.GetProjectTasksInput, types.MapOutput](registry, "bitbucket_get_project_tasks", "Get tasks for a specific project", handler.getProjectTasksHandler)
+	utils.RegisterTool[bitbucket.GetRepositoryTasksInput, types.MapOutput](registry, "bitbucket_get_repository_tasks", "Get tasks for a specific repository", handler.getRepositoryTasksHandler)
 }
@@ -416,39 +416,31 @@ func (h *Handler) setPullRequestUnapproved(ctx context.Context, req *mcp.CallToo
 }
 
 // AddPullRequestTools registers the pull request-related tools with the MCP server
-func AddPullRequestTools(server *mcp.Server, client *bitbucket.BitbucketClient, permissions map[string]bool) {
+func AddPullRequestTools(registry *utils.ToolRegistry, client *bitbucket.BitbucketClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[bitbucket.GetPullRequestsInput, types.MapOutput](server, "bitbucket_get_pull_requests", "Get a list of pull requests", handler.getPullRequestsHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestInput, types.MapOutput](server, "bitbucket_get_pull_request", "Get a specific pull request", handler.getPullRequestHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestActivitiesInput, types.MapOutput](server, "bitbucket_get_pull_request_activities", "Get activities for a specific pull request", handler.getPullRequestActivitiesHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestCommentsInput, types.MapOutput](server, "bitbucket_get_pull_request_comments", "Get comments for a specific pull request", handler.getPullRequestCommentsHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestChangesInput, types.MapOutput](server, "bitbucket_get_pull_request_changes", "Get changes for a specific pull request", handler.getPullRequestChangesHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestDiffStreamInput, DiffOutput](server, "bitbucket_get_pull_request_diff_stream", "Stream the diff for a pull request", handler.getPullRequestDiffStreamHandler)
-	utils.RegisterTool[bitbucket.TestPullRequestCanMergeInput, types.MapOutput](server, "bitbucket_test_pull_request_can_merge", "Test if a pull request can be merged", handler.testPullRequestCanMergeHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestSuggestionsInput, types.MapOutput](server, "bitbucket_get_pull_request_suggestions", "Get pull request suggestions", handler.getPullRequestSuggestionsHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestJiraIssuesInput, types.MapOutput](server, "bitbucket_get_pull_request_jira_issues", "Get Jira issues linked to a pull request", handler.getPullRequestJiraIssuesHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestsForUserInput, types.MapOutput](server, "bitbucket_get_pull_requests_for_user", "Get pull requests for a specific user", handler.getPullRequestsForUserHandler)
-	utils.RegisterTool[bitbucket.GetPullRequestCommentInput, types.MapOutput](server, "bitbucket_get_pull_request_comment", "Get a specific comment on a pull request", handler.getPullRequestCommentHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestsInput, types.MapOutput](registry, "bitbucket_get_pull_requests", "Get a list of pull requests", handler.getPullRequestsHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestInput, types.MapOutput](registry, "bitbucket_get_pull_request", "Get a specific pull request", handler.getPullRequestHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestActivitiesInput, types.MapOutput](registry, "bitbucket_get_pull_request_activities", "Get activities for a specific pull request", handler.getPullRequestActivitiesHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestCommentsInput, types.MapOutput](registry, "bitbucket_get_pull_request_comments", "Get comments for a specific pull request", handler.getPullRequestCommentsHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestChangesInput, types.MapOutput](registry, "bitbucket_get_pull_request_changes", "Get changes for a specific pull request", handler.getPullRequestChangesHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestDiffStreamInput, DiffOutput](registry, "bitbucket_get_pull_request_diff_stream", "Stream the diff for a pull request", handler.getPullRequestDiffStreamHandler)
+	utils.RegisterTool[bitbucket.TestPullRequestCanMergeInput, types.MapOutput](registry, "bitbucket_test_pull_request_can_merge", "Test if a pull request can be merged", handler.testPullRequestCanMergeHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestSuggestionsInput, types.MapOutput](registry, "bitbucket_get_pull_request_suggestions", "Get pull request suggestions", handler.getPullRequestSuggestionsHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestJiraIssuesInput, types.MapOutput](registry, "bitbucket_get_pull_request_jira_issues", "Get Jira issues linked to a pull request", handler.getPullRequestJiraIssuesHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestsForUserInput, types.MapOutput](registry, "bitbucket_get_pull_requests_for_user", "Get pull requests for a specific user", handler.getPullRequestsForUserHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestCommentInput, types.MapOutput](registry, "bitbucket_get_pull_request_comment", "Get a specific comment on a pull request", handler.getPullRequestCommentHandler)
 
 	// Register specific tools for each status
-	if permissions["bitbucket_update_pull_request_status"] {
-		utils.RegisterTool[bitbucket.UpdatePullRequestWithoutStatusInput, types.MapOutput](server, "bitbucket_approve_pull_request", "Set pull request status to approved", handler.setPullRequestApproved)
-		utils.RegisterTool[bitbucket.UpdatePullRequestWithoutStatusInput, types.MapOutput](server, "bitbucket_request_changes_pull_request", "Set pull request status to needs work", handler.setPullRequestNeedsWork)
-		utils.RegisterTool[bitbucket.UpdatePullRequestWithoutStatusInput, types.MapOutput](server, "bitbucket_reset_pull_request_approval", "Set pull request status to unapproved", handler.setPullRequestUnapproved)
-	}
+	utils.RegisterWriteTool[bitbucket.UpdatePullRequestWithoutStatusInput, types.MapOutput](registry, "bitbucket_update_pull_request_status", "bitbucket_approve_pull_request", "Set pull request status to approved", handler.setPullRequestApproved)
+	utils.RegisterWriteTool[bitbucket.UpdatePullRequestWithoutStatusInput, types.MapOutput](registry, "bitbucket_update_pull_request_status", "bitbucket_request_changes_pull_request", "Set pull request status to needs work", handler.setPullRequestNeedsWork)
+	utils.RegisterWriteTool[bitbucket.UpdatePullRequestWithoutStatusInput, types.MapOutput](registry, "bitbucket_update_pull_request_status", "bitbucket_reset_pull_request_approval", "Set pull request status to unapproved", handler.setPullRequestUnapproved)
 
-	utils.RegisterTool[bitbucket.GetPullRequestDiffInput, DiffOutput](server, "bitbucket_get_pull_request_diff", "Get the diff for a specific file in a pull request", handler.getPullRequestDiffHandler)
+	utils.RegisterTool[bitbucket.GetPullRequestDiffInput, DiffOutput](registry, "bitbucket_get_pull_request_diff", "Get the diff for a specific file in a pull request", handler.getPullRequestDiffHandler)
 
-	if permissions["bitbucket_merge_pull_request"] {
-		utils.RegisterTool[bitbucket.MergePullRequestInput, types.MapOutput](server, "bitbucket_merge_pull_request", "Merge a pull request", handler.mergePullRequestHandler)
-	}
+	utils.RegisterWriteTool[bitbucket.MergePullRequestInput, types.MapOutput](registry, "bitbucket_merge_pull_request", "bitbucket_merge_pull_request", "Merge a pull request", handler.mergePullRequestHandler)
 
-	if permissions["bitbucket_decline_pull_request"] {
-		utils.RegisterTool[bitbucket.DeclinePullRequestInput, types.MapOutput](server, "bitbucket_decline_pull_request", "Decline a pull request", handler.declinePullRequestHandler)
-	}
+	utils.RegisterWriteTool[bitbucket.DeclinePullRequestInput, types.MapOutput](registry, "bitbucket_decline_pull_request", "bitbucket_decline_pull_request", "Decline a pull request", handler.declinePullRequestHandler)
 
-	if permissions["bitbucket_add_pull_request_comment"] {
-		utils.RegisterTool[bitbucket.AddPullRequestCommentInput, types.MapOutput](server, "bitbucket_add_pull_request_comment", "Add an enhanced comment to a pull request. Supports general comments, replies, inline comments, and code suggestions", handler.addPullRequestCommentHandler)
-	}
+	utils.RegisterWriteTool[bitbucket.AddPullRequestCommentInput, types.MapOutput](registry, "bitbucket_add_pull_request_comment", "bitbucket_add_pull_request_comment", "Add an enhanced comment to a pull request. Supports general comments, replies, inline comments, and code suggestions", handler.addPullRequestCommentHandler)
 }
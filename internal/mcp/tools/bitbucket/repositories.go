@@ -123,18 +123,18 @@ func (h *Handler) getRelatedRepositoriesHandler(ctx context.Context, req *mcp.Ca
 }
 
 // AddRepositoryTools registers the repository-related tools with the MCP server
-func AddRepositoryTools(server *mcp.Server, client *bitbucket.BitbucketClient, permissions map[string]bool) {
+func AddRepositoryTools(registry *utils.ToolRegistry, client *bitbucket.BitbucketClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[bitbucket.GetRepositoryInput, types.MapOutput](server, "bitbucket_get_repository", "Get a repository", handler.getRepositoryHandler)
-	utils.RegisterTool[bitbucket.GetRepositoriesInput, types.MapOutput](server, "bitbucket_get_repositories", "Get a list of repositories", handler.getRepositoriesHandler)
-	utils.RegisterTool[bitbucket.GetProjectRepositoriesInput, types.MapOutput](server, "bitbucket_get_project_repositories", "Get repositories in a project", handler.getProjectRepositoriesHandler)
-	utils.RegisterTool[bitbucket.GetRepositoryLabelsInput, types.MapOutput](server, "bitbucket_get_repository_labels", "Get repository labels", handler.getRepositoryLabelsHandler)
-	utils.RegisterTool[bitbucket.GetFileContentInput, ContentOutput](server, "bitbucket_get_file_content", "Get file content", handler.getFileContentHandler)
-	utils.RegisterTool[bitbucket.GetReadmeInput, types.MapOutput](server, "bitbucket_get_readme", "Get repository readme", handler.getReadmeHandler)
-	utils.RegisterTool[bitbucket.GetFilesInput, types.MapOutput](server, "bitbucket_get_files", "Get files in a repository path", handler.getFilesHandler)
-	utils.RegisterTool[bitbucket.GetChangesInput, types.MapOutput](server, "bitbucket_get_changes", "Get changes in a repository", handler.getChangesHandler)
-	utils.RegisterTool[bitbucket.CompareChangesInput, types.MapOutput](server, "bitbucket_compare_changes", "Compare changes between commits", handler.compareChangesHandler)
-	utils.RegisterTool[bitbucket.GetForksInput, types.MapOutput](server, "bitbucket_get_forks", "Get forks of a repository", handler.getForksHandler)
-	utils.RegisterTool[bitbucket.GetRelatedRepositoriesInput, types.MapOutput](server, "bitbucket_get_related_repositories", "Get related repositories", handler.getRelatedRepositoriesHandler)
+	utils.RegisterTool[bitbucket.GetRepositoryInput, types.MapOutput](registry, "bitbucket_get_repository", "Get a repository", handler.getRepositoryHandler)
+	utils.RegisterTool[bitbucket.GetRepositoriesInput, types.MapOutput](registry, "bitbucket_get_repositories", "Get a list of repositories", handler.getRepositoriesHandler)
+	utils.RegisterTool[bitbucket.GetProjectRepositoriesInput, types.MapOutput](registry, "bitbucket_get_project_repositories", "Get repositories in a project", handler.getProjectRepositoriesHandler)
+	utils.RegisterTool[bitbucket.GetRepositoryLabelsInput, types.MapOutput](registry, "bitbucket_get_repository_labels", "Get repository labels", handler.getRepositoryLabelsHandler)
+	utils.RegisterTool[bitbucket.GetFileContentInput, ContentOutput](registry, "bitbucket_get_file_content", "Get file content", handler.getFileContentHandler)
+	utils.RegisterTool[bitbucket.GetReadmeInput, types.MapOutput](registry, "bitbucket_get_readme", "Get repository readme", handler.getReadmeHandler)
+	utils.RegisterTool[bitbucket.GetFilesInput, types.MapOutput](registry, "bitbucket_get_files", "Get files in a repository path", handler.getFilesHandler)
+	utils.RegisterTool[bitbucket.GetChangesInput, types.MapOutput](registry, "bitbucket_get_changes", "Get changes in a repository", handler.getChangesHandler)
+	utils.RegisterTool[bitbucket.CompareChangesInput, types.MapOutput](registry, "bitbucket_compare_changes", "Compare changes between commits", handler.compareChangesHandler)
+	utils.RegisterTool[bitbucket.GetForksInput, types.MapOutput](registry, "bitbucket_get_forks", "Get forks of a repository", handler.getForksHandler)
+	utils.RegisterTool[bitbucket.GetRelatedRepositoriesInput, types.MapOutput](registry, "bitbucket_get_related_repositories", "Get related repositories", handler.getRelatedRepositoriesHandler)
 }
@@ -43,11 +43,11 @@ func (h *Handler) searchCodeHandler(ctx context.Context, req *mcp.CallToolReques
 }
 
 // AddSearchTools registers the search-related tools with the MCP server
-func AddSearchTools(server *mcp.Server, client *bitbucket.BitbucketClient, permissions map[string]bool) {
+func AddSearchTools(registry *utils.ToolRegistry, client *bitbucket.BitbucketClient) {
 	handler := NewHandler(client)
 
 	utils.RegisterTool[bitbucket.SearchCodeInput, types.MapOutput](
-		server,
+		registry,
 		"bitbucket_search_code",
 		"Search for code in Bitbucket repositories with enhanced contextual search capabilities",
 		handler.searchCodeHandler)
@@ -32,9 +32,9 @@ func (h *Handler) getTagHandler(ctx context.Context, req *mcp.CallToolRequest, i
 }
 
 // AddTagTools registers the tag-related tools with the MCP server
-func AddTagTools(server *mcp.Server, client *bitbucket.BitbucketClient, permissions map[string]bool) {
+func AddTagTools(registry *utils.ToolRegistry, client *bitbucket.BitbucketClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[bitbucket.GetTagsInput, types.MapOutput](server, "bitbucket_get_tags", "Get tags in a repository", handler.getTagsHandler)
-	utils.RegisterTool[bitbucket.GetTagInput, types.MapOutput](server, "bitbucket_get_tag", "Get a specific tag in a repository", handler.getTagHandler)
+	utils.RegisterTool[bitbucket.GetTagsInput, types.MapOutput](registry, "bitbucket_get_tags", "Get tags in a repository", handler.getTagsHandler)
+	utils.RegisterTool[bitbucket.GetTagInput, types.MapOutput](registry, "bitbucket_get_tag", "Get a specific tag in a repository", handler.getTagHandler)
 }
@@ -32,9 +32,9 @@ func (h *Handler) getUsersHandler(ctx context.Context, req *mcp.CallToolRequest,
 }
 
 // AddUserTools registers the user-related tools with the MCP server
-func AddUserTools(server *mcp.Server, client *bitbucket.BitbucketClient, permissions map[string]bool) {
+func AddUserTools(registry *utils.ToolRegistry, client *bitbucket.BitbucketClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[bitbucket.GetUserInput, GetUserOutput](server, "bitbucket_get_user", "Get a Bitbucket user", handler.getUserHandler)
-	utils.RegisterTool[bitbucket.GetUsersInput, types.MapOutput](server, "bitbucket_get_users", "Get a list of Bitbucket users", handler.getUsersHandler)
+	utils.RegisterTool[bitbucket.GetUserInput, GetUserOutput](registry, "bitbucket_get_user", "Get a Bitbucket user", handler.getUserHandler)
+	utils.RegisterTool[bitbucket.GetUsersInput, types.MapOutput](registry, "bitbucket_get_users", "Get a list of Bitbucket users", handler.getUsersHandler)
 }
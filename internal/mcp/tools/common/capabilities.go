@@ -35,6 +35,6 @@ func capabilitiesHandler(ctx context.Context, req *mcp.CallToolRequest, input Ca
 }
 
 // AddCapabilitiesTool registers the capabilities tool with the MCP server.
-func AddCapabilitiesTool(server *mcp.Server) {
-	utils.RegisterTool[CapabilitiesInput, CapabilitiesOutput](server, "capabilities", "Get detailed information about what tools and operations are supported by this server, including Jira, Confluence, and Bitbucket integrations.", capabilitiesHandler)
+func AddCapabilitiesTool(registry *utils.ToolRegistry) {
+	utils.RegisterTool[CapabilitiesInput, CapabilitiesOutput](registry, "capabilities", "Get detailed information about what tools and operations are supported by this server, including Jira, Confluence, and Bitbucket integrations.", capabilitiesHandler)
 }
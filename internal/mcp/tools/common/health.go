@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"atlassian-dc-mcp-go/internal/client"
 	"atlassian-dc-mcp-go/internal/client/bitbucket"
 	"atlassian-dc-mcp-go/internal/client/confluence"
 	"atlassian-dc-mcp-go/internal/client/jira"
@@ -40,8 +41,9 @@ type HealthCheckInput types.EmptyInput
 
 // ServiceStatus represents the status of a service
 type ServiceStatus struct {
-	Status  string `json:"status"`
-	Message string `json:"message,omitempty"`
+	Status    string                 `json:"status"`
+	Message   string                 `json:"message,omitempty"`
+	RateLimit *client.RateLimitState `json:"rateLimit,omitempty"`
 }
 
 // HealthCheckOutput represents the output of the health check tool
@@ -130,6 +132,9 @@ func performHealthCheck(ctx context.Context, jiraClient *jira.JiraClient, conflu
 			Status:  getStringValue(jiraStatus["status"]),
 			Message: getStringValue(jiraStatus["message"]),
 		}
+		if jiraClient != nil {
+			status.Jira.RateLimit = rateLimitState(jiraClient.BaseClient)
+		}
 	}()
 
 	// Check Confluence
@@ -141,6 +146,9 @@ func performHealthCheck(ctx context.Context, jiraClient *jira.JiraClient, conflu
 			Status:  getStringValue(confluenceStatus["status"]),
 			Message: getStringValue(confluenceStatus["message"]),
 		}
+		if confluenceClient != nil {
+			status.Confluence.RateLimit = rateLimitState(confluenceClient.BaseClient)
+		}
 	}()
 
 	// Check Bitbucket
@@ -152,6 +160,9 @@ func performHealthCheck(ctx context.Context, jiraClient *jira.JiraClient, conflu
 			Status:  getStringValue(bitbucketStatus["status"]),
 			Message: getStringValue(bitbucketStatus["message"]),
 		}
+		if bitbucketClient != nil {
+			status.Bitbucket.RateLimit = rateLimitState(bitbucketClient.BaseClient)
+		}
 	}()
 
 	wg.Wait()
@@ -159,6 +170,15 @@ func performHealthCheck(ctx context.Context, jiraClient *jira.JiraClient, conflu
 	return status
 }
 
+// rateLimitState returns the current rate limiter state of a client
+func rateLimitState(c *client.BaseClient) *client.RateLimitState {
+	if c == nil || c.Limiter == nil {
+		return nil
+	}
+	state := c.Limiter.State()
+	return &state
+}
+
 // getStringValue safely extracts a string value from an interface{}
 func getStringValue(v interface{}) string {
 	if v == nil {
@@ -181,7 +201,7 @@ func (h *Handler) healthCheckHandler(ctx context.Context, req *mcp.CallToolReque
 }
 
 // AddHealthCheckTool registers the health check tool with the MCP server using the new generic API.
-func AddHealthCheckTool(server *mcp.Server, appServer AppServer) {
+func AddHealthCheckTool(registry *utils.ToolRegistry, appServer AppServer) {
 	handler := NewHandler(appServer)
-	utils.RegisterTool[HealthCheckInput, HealthCheckOutput](server, "health_check", "Check the health status of the configured services (Jira, Confluence, Bitbucket).", handler.healthCheckHandler)
+	utils.RegisterTool[HealthCheckInput, HealthCheckOutput](registry, "health_check", "Check the health status of the configured services (Jira, Confluence, Bitbucket) and their current rate limit state.", handler.healthCheckHandler)
 }
@@ -42,10 +42,10 @@ func (h *Handler) getContentCommentsHandler(ctx context.Context, req *mcp.CallTo
 }
 
 // AddChildrenTools registers the children-related tools with the MCP server
-func AddChildrenTools(server *mcp.Server, client *confluence.ConfluenceClient, permissions map[string]bool) {
+func AddChildrenTools(registry *utils.ToolRegistry, client *confluence.ConfluenceClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[confluence.GetContentChildrenInput, types.MapOutput](server, "confluence_get_content_children", "Get content children", handler.getContentChildrenHandler)
-	utils.RegisterTool[confluence.GetContentChildrenByTypeInput, types.MapOutput](server, "confluence_get_content_children_by_type", "Get content children by type", handler.getContentChildrenByTypeHandler)
-	utils.RegisterTool[confluence.GetContentCommentsInput, types.MapOutput](server, "confluence_get_content_comments", "Get content comments", handler.getContentCommentsHandler)
+	utils.RegisterTool[confluence.GetContentChildrenInput, types.MapOutput](registry, "confluence_get_content_children", "Get content children", handler.getContentChildrenHandler)
+	utils.RegisterTool[confluence.GetContentChildrenByTypeInput, types.MapOutput](registry, "confluence_get_content_children_by_type", "Get content children by type", handler.getContentChildrenByTypeHandler)
+	utils.RegisterTool[confluence.GetContentCommentsInput, types.MapOutput](registry, "confluence_get_content_comments", "Get content comments", handler.getContentCommentsHandler)
 }
@@ -62,6 +62,16 @@ func (h *Handler) updateContentHandler(ctx context.Context, req *mcp.CallToolReq
 	return nil, content, nil
 }
 
+// updatePageHandler handles updating a Confluence page based on its current version
+func (h *Handler) updatePageHandler(ctx context.Context, req *mcp.CallToolRequest, input confluence.UpdatePageInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	page, err := h.client.UpdatePage(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("update page failed: %w", err)
+	}
+
+	return nil, page, nil
+}
+
 // deleteContentHandler handles deleting Confluence content
 func (h *Handler) deleteContentHandler(ctx context.Context, req *mcp.CallToolRequest, input confluence.DeleteContentInput) (*mcp.CallToolResult, types.MapOutput, error) {
 	err := h.client.DeleteContent(ctx, input)
@@ -147,32 +157,26 @@ func (h *Handler) addCommentHandler(ctx context.Context, req *mcp.CallToolReques
 }
 
 // AddContentTools registers the content-related tools with the MCP server
-func AddContentTools(server *mcp.Server, client *confluence.ConfluenceClient, permissions map[string]bool) {
+func AddContentTools(registry *utils.ToolRegistry, client *confluence.ConfluenceClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[confluence.GetContentInput, types.MapOutput](server, "confluence_get_content", "Get a list of Confluence content. This tool allows you to retrieve multiple content items with various filter options.", handler.getContentHandler)
-	utils.RegisterTool[confluence.SearchContentInput, types.MapOutput](server, "confluence_search_content", "Search for Confluence content using CQL (Confluence Query Language). This tool allows you to find content based on various criteria such as text, space, labels, and more.", handler.searchContentHandler)
-	utils.RegisterTool[confluence.GetContentByIDInput, types.MapOutput](server, "confluence_get_content_by_id", "Get a specific Confluence content item by its ID. This tool allows you to retrieve detailed information about a content item including its body, metadata, and version history.", handler.getContentByIDHandler)
-	utils.RegisterTool[confluence.GetContentHistoryInput, types.MapOutput](server, "confluence_get_content_history", "Retrieve the history of a Confluence content item. This tool provides detailed information about all versions of a content item.", handler.getContentHistoryHandler)
-	utils.RegisterTool[confluence.GetContentLabelsInput, types.MapOutput](server, "confluence_get_content_labels", "Get labels for a specific Confluence content item. This tool allows you to retrieve all labels associated with a content item.", handler.getContentLabelsHandler)
-	utils.RegisterTool[confluence.GetAttachmentsInput, types.MapOutput](server, "confluence_get_attachments", "Get attachments for a specific Confluence content item.", handler.getAttachmentsHandler)
-	utils.RegisterTool[confluence.GetExtractedTextInput, types.MapOutput](server, "confluence_get_extracted_text", "Get extracted text from a Confluence attachment.", handler.getExtractedTextHandler)
-	utils.RegisterTool[confluence.ScanContentBySpaceKeyInput, types.MapOutput](server, "confluence_scan_content_by_space_key", "Scan Confluence content by space key.", handler.scanContentBySpaceKeyHandler)
-	utils.RegisterTool[confluence.SearchInput, types.MapOutput](server, "confluence_search", "Search Confluence using the Search API.", handler.searchHandler)
-
-	if permissions["confluence_create_content"] {
-		utils.RegisterTool[confluence.CreateContentInput, types.MapOutput](server, "confluence_create_content", "Create new Confluence content. This tool allows you to create pages, blog posts, and other content types.", handler.createContentHandler)
-	}
+	utils.RegisterTool[confluence.GetContentInput, types.MapOutput](registry, "confluence_get_content", "Get a list of Confluence content. This tool allows you to retrieve multiple content items with various filter options.", handler.getContentHandler)
+	utils.RegisterTool[confluence.SearchContentInput, types.MapOutput](registry, "confluence_search_content", "Search for Confluence content using CQL (Confluence Query Language). This tool allows you to find content based on various criteria such as text, space, labels, and more.", handler.searchContentHandler)
+	utils.RegisterTool[confluence.GetContentByIDInput, types.MapOutput](registry, "confluence_get_content_by_id", "Get a specific Confluence content item by its ID. This tool allows you to retrieve detailed information about a content item including its body, metadata, and version history. Set representation to markdown to get the body as Markdown instead of the storage format.", handler.getContentByIDHandler)
+	utils.RegisterTool[confluence.GetContentHistoryInput, types.MapOutput](registry, "confluence_get_content_history", "Retrieve the history of a Confluence content item. This tool provides detailed information about all versions of a content item.", handler.getContentHistoryHandler)
+	utils.RegisterTool[confluence.GetContentLabelsInput, types.MapOutput](registry, "confluence_get_content_labels", "Get labels for a specific Confluence content item. This tool allows you to retrieve all labels associated with a content item.", handler.getContentLabelsHandler)
+	utils.RegisterTool[confluence.GetAttachmentsInput, types.MapOutput](registry, "confluence_get_attachments", "Get attachments for a specific Confluence content item.", handler.getAttachmentsHandler)
+	utils.RegisterTool[confluence.GetExtractedTextInput, types.MapOutput](registry, "confluence_get_extracted_text", "Get extracted text from a Confluence attachment.", handler.getExtractedTextHandler)
+	utils.RegisterTool[confluence.ScanContentBySpaceKeyInput, types.MapOutput](registry, "confluence_scan_content_by_space_key", "Scan Confluence content by space key.", handler.scanContentBySpaceKeyHandler)
+	utils.RegisterTool[confluence.SearchInput, types.MapOutput](registry, "confluence_search", "Search Confluence using the Search API.", handler.searchHandler)
 
-	if permissions["confluence_update_content"] {
-		utils.RegisterTool[confluence.UpdateContentInput, types.MapOutput](server, "confluence_update_content", "Update existing Confluence content. This tool allows you to modify various aspects of existing content such as title, body, and other properties.", handler.updateContentHandler)
-	}
+	utils.RegisterWriteTool[confluence.CreateContentInput, types.MapOutput](registry, "confluence_create_content", "confluence_create_content", "Create new Confluence content. This tool allows you to create pages, blog posts, and other content types. Set representation to markdown to write body.storage.value as Markdown.", handler.createContentHandler)
 
-	if permissions["confluence_delete_content"] {
-		utils.RegisterTool[confluence.DeleteContentInput, types.MapOutput](server, "confluence_delete_content", "Delete Confluence content by ID. This tool allows you to permanently remove content from Confluence.", handler.deleteContentHandler)
-	}
+	utils.RegisterWriteTool[confluence.UpdateContentInput, types.MapOutput](registry, "confluence_update_content", "confluence_update_content", "Update existing Confluence content. This tool allows you to modify various aspects of existing content such as title, body, and other properties. Set representation to markdown to write contentData.body.storage.value as Markdown.", handler.updateContentHandler)
 
-	if permissions["confluence_add_comment"] {
-		utils.RegisterTool[confluence.AddCommentInput, types.MapOutput](server, "confluence_add_comment", "Add a comment to Confluence content. This tool allows you to attach comments to specific content items.", handler.addCommentHandler)
-	}
+	utils.RegisterWriteTool[confluence.UpdatePageInput, types.MapOutput](registry, "confluence_update_page", "confluence_update_page", "Update the title and body of a Confluence page. The current version is fetched and incremented, and the type, title, status, space and parent are carried over. Pass expectedVersion to fail with a conflict if the page has been edited since you read it.", handler.updatePageHandler)
+
+	utils.RegisterWriteTool[confluence.DeleteContentInput, types.MapOutput](registry, "confluence_delete_content", "confluence_delete_content", "Delete Confluence content by ID. This tool allows you to permanently remove content from Confluence.", handler.deleteContentHandler)
+
+	utils.RegisterWriteTool[confluence.AddCommentInput, types.MapOutput](registry, "confluence_add_comment", "confluence_add_comment", "Add a comment to Confluence content. This tool allows you to attach comments to specific content items. Set representation to markdown to write the comment as Markdown.", handler.addCommentHandler)
 }
@@ -32,9 +32,9 @@ func (h *Handler) getLabelsHandler(ctx context.Context, req *mcp.CallToolRequest
 }
 
 // AddLabelTools registers the label-related tools with the MCP server
-func AddLabelTools(server *mcp.Server, client *confluence.ConfluenceClient, permissions map[string]bool) {
+func AddLabelTools(registry *utils.ToolRegistry, client *confluence.ConfluenceClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[confluence.GetRelatedLabelsInput, types.MapOutput](server, "confluence_get_related_labels", "Get labels related to a specific label. This tool allows you to find labels that are commonly used together with a given label.", handler.getRelatedLabelsHandler)
-	utils.RegisterTool[confluence.GetLabelsInput, types.MapOutput](server, "confluence_get_labels", "Get labels with various filter options. This tool allows you to retrieve labels based on name, owner, namespace, or space.", handler.getLabelsHandler)
+	utils.RegisterTool[confluence.GetRelatedLabelsInput, types.MapOutput](registry, "confluence_get_related_labels", "Get labels related to a specific label. This tool allows you to find labels that are commonly used together with a given label.", handler.getRelatedLabelsHandler)
+	utils.RegisterTool[confluence.GetLabelsInput, types.MapOutput](registry, "confluence_get_labels", "Get labels with various filter options. This tool allows you to retrieve labels based on name, owner, namespace, or space.", handler.getLabelsHandler)
 }
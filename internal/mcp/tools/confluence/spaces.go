@@ -52,11 +52,11 @@ func (h *Handler) getSpacesByKeyHandler(ctx context.Context, req *mcp.CallToolRe
 }
 
 // AddSpaceTools registers the space-related tools with the MCP server
-func AddSpaceTools(server *mcp.Server, client *confluence.ConfluenceClient, permissions map[string]bool) {
+func AddSpaceTools(registry *utils.ToolRegistry, client *confluence.ConfluenceClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[confluence.GetSpaceInput, types.MapOutput](server, "confluence_get_space", "Get a specific Confluence space by its key. This tool allows you to retrieve detailed information about a space including its name, description, and metadata.", handler.getSpaceHandler)
-	utils.RegisterTool[confluence.GetContentsInSpaceInput, types.MapOutput](server, "confluence_get_contents_in_space", "Get contents in a specific Confluence space. This tool allows you to retrieve all content items within a space.", handler.getContentsInSpaceHandler)
-	utils.RegisterTool[confluence.GetContentsByTypeInput, types.MapOutput](server, "confluence_get_contents_by_type", "Get contents by type in a specific Confluence space. This tool allows you to retrieve content items of a specific type (e.g., page, blogpost) within a space.", handler.getContentsByTypeHandler)
-	utils.RegisterTool[confluence.GetSpacesByKeyInput, types.MapOutput](server, "confluence_get_spaces_by_key", "Get spaces by key with various filter options. This tool allows you to retrieve spaces using multiple filter criteria including keys, IDs, types, status, and labels.", handler.getSpacesByKeyHandler)
+	utils.RegisterTool[confluence.GetSpaceInput, types.MapOutput](registry, "confluence_get_space", "Get a specific Confluence space by its key. This tool allows you to retrieve detailed information about a space including its name, description, and metadata.", handler.getSpaceHandler)
+	utils.RegisterTool[confluence.GetContentsInSpaceInput, types.MapOutput](registry, "confluence_get_contents_in_space", "Get contents in a specific Confluence space. This tool allows you to retrieve all content items within a space.", handler.getContentsInSpaceHandler)
+	utils.RegisterTool[confluence.GetContentsByTypeInput, types.MapOutput](registry, "confluence_get_contents_by_type", "Get contents by type in a specific Confluence space. This tool allows you to retrieve content items of a specific type (e.g., page, blogpost) within a space.", handler.getContentsByTypeHandler)
+	utils.RegisterTool[confluence.GetSpacesByKeyInput, types.MapOutput](registry, "confluence_get_spaces_by_key", "Get spaces by key with various filter options. This tool allows you to retrieve spaces using multiple filter criteria including keys, IDs, types, status, and labels.", handler.getSpacesByKeyHandler)
 }
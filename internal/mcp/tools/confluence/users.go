@@ -22,8 +22,8 @@ func (h *Handler) getCurrentUserHandler(ctx context.Context, req *mcp.CallToolRe
 }
 
 // AddUserTools registers the user-related tools with the MCP server
-func AddUserTools(server *mcp.Server, client *confluence.ConfluenceClient, permissions map[string]bool) {
+func AddUserTools(registry *utils.ToolRegistry, client *confluence.ConfluenceClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[types.EmptyInput, types.MapOutput](server, "confluence_get_current_user", "Get current Confluence user. This tool retrieves information about the currently authenticated user.", handler.getCurrentUserHandler)
+	utils.RegisterTool[types.EmptyInput, types.MapOutput](registry, "confluence_get_current_user", "Get current Confluence user. This tool retrieves information about the currently authenticated user.", handler.getCurrentUserHandler)
 }
@@ -0,0 +1,97 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-dc-mcp-go/internal/client/jira"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+	"atlassian-dc-mcp-go/internal/types"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GetAttachmentsResult represents the result structure for getAttachmentsHandler
+type GetAttachmentsResult struct {
+	Attachments []types.MapOutput `json:"attachments"`
+}
+
+// AttachmentContentResult describes the attachment returned by getAttachmentContentHandler
+type AttachmentContentResult struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	MimeType string `json:"mimeType"`
+	Size     int    `json:"size"`
+	Encoding string `json:"encoding"`
+}
+
+// getAttachmentsHandler handles getting the attachments of a Jira issue
+func (h *Handler) getAttachmentsHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetAttachmentsInput) (*mcp.CallToolResult, GetAttachmentsResult, error) {
+	attachments, err := h.client.GetAttachments(ctx, input)
+	if err != nil {
+		return nil, GetAttachmentsResult{}, fmt.Errorf("get attachments failed: %w", err)
+	}
+
+	result := GetAttachmentsResult{
+		Attachments: attachments,
+	}
+
+	return nil, result, nil
+}
+
+// getAttachmentContentHandler handles getting the content of a Jira attachment.
+// Text is returned as text content and other files as a base64 encoded resource.
+func (h *Handler) getAttachmentContentHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetAttachmentContentInput) (*mcp.CallToolResult, AttachmentContentResult, error) {
+	attachment, err := h.client.GetAttachmentContent(ctx, input)
+	if err != nil {
+		return nil, AttachmentContentResult{}, fmt.Errorf("get attachment content failed: %w", err)
+	}
+
+	result := AttachmentContentResult{
+		ID:       attachment.ID,
+		Filename: attachment.Filename,
+		MimeType: attachment.MimeType,
+		Size:     len(attachment.Data),
+		Encoding: jira.EncodingBase64,
+	}
+
+	var content mcp.Content
+	if attachment.IsText {
+		result.Encoding = jira.EncodingText
+		content = &mcp.TextContent{Text: string(attachment.Data)}
+	} else {
+		content = &mcp.EmbeddedResource{
+			Resource: &mcp.ResourceContents{
+				URI:      attachment.URL,
+				MIMEType: attachment.MimeType,
+				Blob:     attachment.Data,
+			},
+		}
+	}
+
+	return &mcp.CallToolResult{Content: []mcp.Content{content}}, result, nil
+}
+
+// addAttachmentHandler handles adding an attachment to a Jira issue
+func (h *Handler) addAttachmentHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.AddAttachmentInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	attachments, err := h.client.AddAttachment(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("add attachment failed: %w", err)
+	}
+
+	resultMap := types.MapOutput{
+		"attachments": attachments,
+	}
+
+	return nil, resultMap, nil
+}
+
+// AddAttachmentTools registers the attachment-related tools with the MCP server
+func AddAttachmentTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
+	handler := NewHandler(client)
+
+	utils.RegisterTool[jira.GetAttachmentsInput, GetAttachmentsResult](registry, "jira_get_attachments", "Get the attachments of a Jira issue with their IDs, file names, MIME types and sizes", handler.getAttachmentsHandler)
+	utils.RegisterTool[jira.GetAttachmentContentInput, AttachmentContentResult](registry, "jira_get_attachment_content", "Get the content of a Jira attachment. Text files such as logs are returned as text, other files such as screenshots as a base64 encoded resource", handler.getAttachmentContentHandler)
+
+	utils.RegisterWriteTool[jira.AddAttachmentInput, types.MapOutput](registry, "jira_add_attachment", "jira_add_attachment", "Add an attachment to a Jira issue. Set encoding to base64 for binary content", handler.addAttachmentHandler)
+}
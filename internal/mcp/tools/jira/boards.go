@@ -81,15 +81,77 @@ func (h *Handler) getSprintIssuesHandler(ctx context.Context, req *mcp.CallToolR
 	return nil, issues, nil
 }
 
+// createSprintHandler handles creating a Jira sprint
+func (h *Handler) createSprintHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.CreateSprintInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	sprint, err := h.client.CreateSprint(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create sprint failed: %w", err)
+	}
+
+	return nil, sprint, nil
+}
+
+// updateSprintHandler handles updating a Jira sprint
+func (h *Handler) updateSprintHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.UpdateSprintInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	sprint, err := h.client.UpdateSprint(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("update sprint failed: %w", err)
+	}
+
+	return nil, sprint, nil
+}
+
+// moveIssuesToSprintHandler handles moving issues to a Jira sprint
+func (h *Handler) moveIssuesToSprintHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.MoveIssuesToSprintInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	err := h.client.MoveIssuesToSprint(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("move issues to sprint failed: %w", err)
+	}
+
+	resultMap := types.MapOutput{"success": true}
+	return nil, resultMap, nil
+}
+
+// moveIssuesToBacklogHandler handles moving issues to the backlog
+func (h *Handler) moveIssuesToBacklogHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.MoveIssuesToBacklogInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	err := h.client.MoveIssuesToBacklog(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("move issues to backlog failed: %w", err)
+	}
+
+	resultMap := types.MapOutput{"success": true}
+	return nil, resultMap, nil
+}
+
+// rankIssuesHandler handles ranking Jira issues
+func (h *Handler) rankIssuesHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.RankIssuesInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	failed, err := h.client.RankIssues(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("rank issues failed: %w", err)
+	}
+
+	resultMap := types.MapOutput{
+		"success": len(failed) == 0,
+		"failed":  failed,
+	}
+	return nil, resultMap, nil
+}
+
 // AddBoardTools registers the board-related tools with the MCP server
-func AddBoardTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddBoardTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[jira.GetBoardsInput, types.MapOutput](server, "jira_get_boards", "Get Jira boards with optional filters", handler.getBoardsHandler)
-	utils.RegisterTool[jira.GetBoardInput, types.MapOutput](server, "jira_get_board", "Get a specific Jira board by its ID", handler.getBoardHandler)
-	utils.RegisterTool[jira.GetBoardBacklogInput, types.MapOutput](server, "jira_get_board_backlog", "Get backlog issues for a Jira board", handler.getBoardBacklogHandler)
-	utils.RegisterTool[jira.GetBoardEpicsInput, types.MapOutput](server, "jira_get_board_epics", "Get epics associated with a Jira board", handler.getBoardEpicsHandler)
-	utils.RegisterTool[jira.GetBoardSprintsInput, types.MapOutput](server, "jira_get_board_sprints", "Get sprints associated with a Jira board", handler.getBoardSprintsHandler)
-	utils.RegisterTool[jira.GetSprintInput, types.MapOutput](server, "jira_get_sprint", "Get a specific Jira sprint by its ID", handler.getSprintHandler)
-	utils.RegisterTool[jira.GetSprintIssuesInput, types.MapOutput](server, "jira_get_sprint_issues", "Get issues in a specific Jira sprint", handler.getSprintIssuesHandler)
+	utils.RegisterTool[jira.GetBoardsInput, types.MapOutput](registry, "jira_get_boards", "Get Jira boards with optional filters", handler.getBoardsHandler)
+	utils.RegisterTool[jira.GetBoardInput, types.MapOutput](registry, "jira_get_board", "Get a specific Jira board by its ID", handler.getBoardHandler)
+	utils.RegisterTool[jira.GetBoardBacklogInput, types.MapOutput](registry, "jira_get_board_backlog", "Get backlog issues for a Jira board", handler.getBoardBacklogHandler)
+	utils.RegisterTool[jira.GetBoardEpicsInput, types.MapOutput](registry, "jira_get_board_epics", "Get epics associated with a Jira board", handler.getBoardEpicsHandler)
+	utils.RegisterTool[jira.GetBoardSprintsInput, types.MapOutput](registry, "jira_get_board_sprints", "Get sprints associated with a Jira board", handler.getBoardSprintsHandler)
+	utils.RegisterTool[jira.GetSprintInput, types.MapOutput](registry, "jira_get_sprint", "Get a specific Jira sprint by its ID", handler.getSprintHandler)
+	utils.RegisterTool[jira.GetSprintIssuesInput, types.MapOutput](registry, "jira_get_sprint_issues", "Get issues in a specific Jira sprint", handler.getSprintIssuesHandler)
+
+	utils.RegisterWriteTool[jira.CreateSprintInput, types.MapOutput](registry, "jira_create_sprint", "jira_create_sprint", "Create a future sprint on a Jira board", handler.createSprintHandler)
+	utils.RegisterWriteTool[jira.UpdateSprintInput, types.MapOutput](registry, "jira_update_sprint", "jira_update_sprint", "Update the name, goal or dates of a Jira sprint, or start or close it by setting its state to active or closed", handler.updateSprintHandler)
+	utils.RegisterWriteTool[jira.MoveIssuesToSprintInput, types.MapOutput](registry, "jira_move_issues_to_sprint", "jira_move_issues_to_sprint", "Move Jira issues to a sprint", handler.moveIssuesToSprintHandler)
+	utils.RegisterWriteTool[jira.MoveIssuesToBacklogInput, types.MapOutput](registry, "jira_move_issues_to_backlog", "jira_move_issues_to_backlog", "Move Jira issues to the backlog, removing them from their sprints", handler.moveIssuesToBacklogHandler)
+	utils.RegisterWriteTool[jira.RankIssuesInput, types.MapOutput](registry, "jira_rank_issues", "jira_rank_issues", "Rank Jira issues before or after another issue, keeping the given order. Returns the issues that could not be ranked", handler.rankIssuesHandler)
 }
@@ -32,13 +32,10 @@ func (h *Handler) addCommentHandler(ctx context.Context, req *mcp.CallToolReques
 }
 
 // AddCommentTools registers the comment-related tools with the MCP server
-func AddCommentTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddCommentTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[jira.GetCommentsInput, types.MapOutput](server, "jira_get_comments", "Get comments for a Jira issue", handler.getCommentsHandler)
+	utils.RegisterTool[jira.GetCommentsInput, types.MapOutput](registry, "jira_get_comments", "Get comments for a Jira issue. Set format to markdown to get the comment bodies as Markdown instead of wiki markup.", handler.getCommentsHandler)
 
-	// Only register write tools if write permission is enabled
-	if permissions["jira_add_comment"] {
-		utils.RegisterTool[jira.AddCommentInput, types.MapOutput](server, "jira_add_comment", "Add a comment to a Jira issue", handler.addCommentHandler)
-	}
+	utils.RegisterWriteTool[jira.AddCommentInput, types.MapOutput](registry, "jira_add_comment", "jira_add_comment", "Add a comment to a Jira issue. Set format to markdown to write the comment as Markdown.", handler.addCommentHandler)
 }
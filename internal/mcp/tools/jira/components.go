@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-dc-mcp-go/internal/client/jira"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+	"atlassian-dc-mcp-go/internal/types"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GetProjectComponentsResult represents the result structure for getProjectComponentsHandler
+type GetProjectComponentsResult struct {
+	Components []types.MapOutput `json:"components"`
+}
+
+// getProjectComponentsHandler handles getting the components of a Jira project
+func (h *Handler) getProjectComponentsHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetProjectComponentsInput) (*mcp.CallToolResult, GetProjectComponentsResult, error) {
+	components, err := h.client.GetProjectComponents(ctx, input)
+	if err != nil {
+		return nil, GetProjectComponentsResult{}, fmt.Errorf("get project components failed: %w", err)
+	}
+
+	result := GetProjectComponentsResult{
+		Components: components,
+	}
+
+	return nil, result, nil
+}
+
+// createComponentHandler handles creating a Jira component
+func (h *Handler) createComponentHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.CreateComponentInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	component, err := h.client.CreateComponent(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create component failed: %w", err)
+	}
+
+	return nil, component, nil
+}
+
+// AddComponentTools registers the component-related tools with the MCP server
+func AddComponentTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
+	handler := NewHandler(client)
+
+	utils.RegisterTool[jira.GetProjectComponentsInput, GetProjectComponentsResult](registry, "jira_get_project_components", "Get the components of a Jira project with their leads and default assignees", handler.getProjectComponentsHandler)
+
+	utils.RegisterWriteTool[jira.CreateComponentInput, types.MapOutput](registry, "jira_create_component", "jira_create_component", "Create a component in a Jira project", handler.createComponentHandler)
+}
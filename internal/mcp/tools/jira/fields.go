@@ -0,0 +1,49 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-dc-mcp-go/internal/client/jira"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+	"atlassian-dc-mcp-go/internal/types"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GetFieldsResult represents the result structure for getFieldsHandler
+type GetFieldsResult struct {
+	Fields []jira.Field `json:"fields"`
+}
+
+// getFieldsHandler handles getting the fields of the Jira instance
+func (h *Handler) getFieldsHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetFieldsInput) (*mcp.CallToolResult, GetFieldsResult, error) {
+	fields, err := h.client.GetFields(ctx, input)
+	if err != nil {
+		return nil, GetFieldsResult{}, fmt.Errorf("get fields failed: %w", err)
+	}
+
+	result := GetFieldsResult{
+		Fields: fields,
+	}
+
+	return nil, result, nil
+}
+
+// getCreateMetaHandler handles getting the create metadata of a Jira project
+func (h *Handler) getCreateMetaHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetCreateMetaInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	meta, err := h.client.GetCreateMeta(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get create meta failed: %w", err)
+	}
+
+	return nil, meta, nil
+}
+
+// AddFieldTools registers the field-related tools with the MCP server
+func AddFieldTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
+	handler := NewHandler(client)
+
+	utils.RegisterTool[jira.GetFieldsInput, GetFieldsResult](registry, "jira_get_fields", "Get the system and custom fields of Jira with their IDs and types, to find the ID of a custom field such as Story Points", handler.getFieldsHandler)
+	utils.RegisterTool[jira.GetCreateMetaInput, types.MapOutput](registry, "jira_get_create_meta", "Get the issue types of a Jira project, or with issueType the fields of its create screen with whether they are required and their allowed values", handler.getCreateMetaHandler)
+}
@@ -0,0 +1,72 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-dc-mcp-go/internal/client/jira"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+	"atlassian-dc-mcp-go/internal/types"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GetFavouriteFiltersResult represents the result structure for getFavouriteFiltersHandler
+type GetFavouriteFiltersResult struct {
+	Filters []types.MapOutput `json:"filters"`
+}
+
+// getFavouriteFiltersHandler handles getting the favourite filters of the current user
+func (h *Handler) getFavouriteFiltersHandler(ctx context.Context, req *mcp.CallToolRequest, input types.EmptyInput) (*mcp.CallToolResult, GetFavouriteFiltersResult, error) {
+	filters, err := h.client.GetFavouriteFilters(ctx)
+	if err != nil {
+		return nil, GetFavouriteFiltersResult{}, fmt.Errorf("get favourite filters failed: %w", err)
+	}
+
+	result := GetFavouriteFiltersResult{
+		Filters: filters,
+	}
+
+	return nil, result, nil
+}
+
+// getFilterHandler handles getting a saved filter
+func (h *Handler) getFilterHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetFilterInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	filter, err := h.client.GetFilter(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get filter failed: %w", err)
+	}
+
+	return nil, filter, nil
+}
+
+// searchByFilterHandler handles searching for issues with a saved filter
+func (h *Handler) searchByFilterHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.SearchByFilterInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	issues, err := h.client.SearchByFilter(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("search by filter failed: %w", err)
+	}
+
+	return nil, issues, nil
+}
+
+// createFilterHandler handles creating a saved filter
+func (h *Handler) createFilterHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.CreateFilterInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	filter, err := h.client.CreateFilter(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create filter failed: %w", err)
+	}
+
+	return nil, filter, nil
+}
+
+// AddFilterTools registers the saved filter tools with the MCP server
+func AddFilterTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
+	handler := NewHandler(client)
+
+	utils.RegisterTool[types.EmptyInput, GetFavouriteFiltersResult](registry, "jira_get_favourite_filters", "Get your favourite Jira filters with their IDs and JQL", handler.getFavouriteFiltersHandler)
+	utils.RegisterTool[jira.GetFilterInput, types.MapOutput](registry, "jira_get_filter", "Get a saved Jira filter by ID with its JQL", handler.getFilterHandler)
+	utils.RegisterTool[jira.SearchByFilterInput, types.MapOutput](registry, "jira_search_by_filter", "Search for Jira issues with the JQL of a saved filter, given by ID or by the name of one of your favourite filters", handler.searchByFilterHandler)
+
+	utils.RegisterWriteTool[jira.CreateFilterInput, types.MapOutput](registry, "jira_create_filter", "jira_create_filter", "Create a saved Jira filter from a JQL query", handler.createFilterHandler)
+}
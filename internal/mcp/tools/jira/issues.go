@@ -22,6 +22,16 @@ func (h *Handler) getIssueHandler(ctx context.Context, req *mcp.CallToolRequest,
 	return nil, issue, nil
 }
 
+// getIssueChangelogHandler retrieves the change history of a Jira issue.
+func (h *Handler) getIssueChangelogHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetIssueChangelogInput) (*mcp.CallToolResult, jira.IssueChangelog, error) {
+	changelog, err := h.client.GetIssueChangelog(ctx, input)
+	if err != nil {
+		return nil, jira.IssueChangelog{}, fmt.Errorf("get issue changelog failed: %w", err)
+	}
+
+	return nil, *changelog, nil
+}
+
 // createIssueHandler creates a new Jira issue.
 func (h *Handler) createIssueHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.CreateIssueInput) (*mcp.CallToolResult, types.MapOutput, error) {
 	issue, err := h.client.CreateIssue(ctx, input)
@@ -103,25 +113,20 @@ func (h *Handler) searchIssuesHandler(ctx context.Context, req *mcp.CallToolRequ
 }
 
 // AddIssueTools registers the issue-related tools with the MCP server
-func AddIssueTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddIssueTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[jira.SearchIssuesInput, types.MapOutput](server, "jira_search_issues", "Search for Jira issues using JQL", handler.searchIssuesHandler)
-	utils.RegisterTool[jira.GetIssueInput, types.MapOutput](server, "jira_get_issue", "Get a specific Jira issue by key or ID", handler.getIssueHandler)
-	utils.RegisterTool[jira.GetAgileIssueInput, types.MapOutput](server, "jira_get_agile_issue", "Get an agile Jira issue by key or ID", handler.getAgileIssueHandler)
-	utils.RegisterTool[jira.GetIssueEstimationForBoardInput, types.MapOutput](server, "jira_get_issue_estimation_for_board", "Get issue estimation for a board", handler.getIssueEstimationForBoardHandler)
+	utils.RegisterTool[jira.SearchIssuesInput, types.MapOutput](registry, "jira_search_issues", "Search for Jira issues using JQL. Set format to markdown to return descriptions and comments as Markdown. Set namedCustomFields to return custom fields under their names.", handler.searchIssuesHandler)
+	utils.RegisterTool[jira.GetIssueInput, types.MapOutput](registry, "jira_get_issue", "Get a specific Jira issue by key or ID. Set format to markdown to get the description, environment and comments as Markdown instead of wiki markup. Set namedCustomFields to return custom fields under their names.", handler.getIssueHandler)
+	utils.RegisterTool[jira.GetIssueChangelogInput, jira.IssueChangelog](registry, "jira_get_issue_changelog", "Get the change history of a Jira issue as a timeline of field changes with their authors and timestamps, filterable by field and date range, and the time the issue spent in each status", handler.getIssueChangelogHandler)
+	utils.RegisterTool[jira.GetAgileIssueInput, types.MapOutput](registry, "jira_get_agile_issue", "Get an agile Jira issue by key or ID", handler.getAgileIssueHandler)
+	utils.RegisterTool[jira.GetIssueEstimationForBoardInput, types.MapOutput](registry, "jira_get_issue_estimation_for_board", "Get issue estimation for a board", handler.getIssueEstimationForBoardHandler)
 
-	if permissions["jira_set_issue_estimation_for_board"] {
-		utils.RegisterTool[jira.SetIssueEstimationForBoardInput, types.MapOutput](server, "jira_set_issue_estimation_for_board", "Set issue estimation for a board", handler.setIssueEstimationForBoardHandler)
-	}
+	utils.RegisterWriteTool[jira.SetIssueEstimationForBoardInput, types.MapOutput](registry, "jira_set_issue_estimation_for_board", "jira_set_issue_estimation_for_board", "Set issue estimation for a board", handler.setIssueEstimationForBoardHandler)
 
-	if permissions["jira_create_issue"] {
-		utils.RegisterTool[jira.CreateIssueInput, types.MapOutput](server, "jira_create_issue", "Create a new Jira issue", handler.createIssueHandler)
-		utils.RegisterTool[jira.CreateIssueWithPayloadInput, types.MapOutput](server, "jira_create_issue_with_payload", "Create a new Jira issue with a custom payload", handler.createIssueWithPayloadHandler)
-	}
+	utils.RegisterWriteTool[jira.CreateIssueInput, types.MapOutput](registry, "jira_create_issue", "jira_create_issue", "Create a new Jira issue. Set format to markdown to write the description as Markdown. Additional fields, including custom fields, can be set by name in fields.", handler.createIssueHandler)
+	utils.RegisterWriteTool[jira.CreateIssueWithPayloadInput, types.MapOutput](registry, "jira_create_issue", "jira_create_issue_with_payload", "Create a new Jira issue with a custom payload", handler.createIssueWithPayloadHandler)
 
-	if permissions["jira_update_issue"] {
-		utils.RegisterTool[jira.UpdateIssueInput, types.MapOutput](server, "jira_update_issue", "Update an existing Jira issue", handler.updateIssueHandler)
-		utils.RegisterTool[jira.UpdateIssueWithOptionsInput, types.MapOutput](server, "jira_update_issue_with_options", "Update an existing Jira issue with additional options", handler.updateIssueWithOptionsHandler)
-	}
+	utils.RegisterWriteTool[jira.UpdateIssueInput, types.MapOutput](registry, "jira_update_issue", "jira_update_issue", "Update an existing Jira issue. Fields can be given by name or ID. Set format to markdown to write the description and environment as Markdown.", handler.updateIssueHandler)
+	utils.RegisterWriteTool[jira.UpdateIssueWithOptionsInput, types.MapOutput](registry, "jira_update_issue", "jira_update_issue_with_options", "Update an existing Jira issue with additional options. Fields can be given by name or ID. Set format to markdown to write the description and environment as Markdown.", handler.updateIssueWithOptionsHandler)
 }
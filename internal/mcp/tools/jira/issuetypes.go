@@ -26,8 +26,8 @@ func (h *Handler) getIssueTypesHandler(ctx context.Context, req *mcp.CallToolReq
 }
 
 // AddIssueTypeTools registers the issue type-related tools with the MCP server
-func AddIssueTypeTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddIssueTypeTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[types.EmptyInput, types.MapOutput](server, "jira_get_issue_types", "Get Jira issue types", handler.getIssueTypesHandler)
+	utils.RegisterTool[types.EmptyInput, types.MapOutput](registry, "jira_get_issue_types", "Get Jira issue types", handler.getIssueTypesHandler)
 }
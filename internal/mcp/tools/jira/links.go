@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-dc-mcp-go/internal/client/jira"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+	"atlassian-dc-mcp-go/internal/types"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GetRemoteLinksResult represents the result structure for getRemoteLinksHandler
+type GetRemoteLinksResult struct {
+	RemoteLinks []types.MapOutput `json:"remoteLinks"`
+}
+
+// getIssueLinkTypesHandler handles getting the issue link types
+func (h *Handler) getIssueLinkTypesHandler(ctx context.Context, req *mcp.CallToolRequest, input types.EmptyInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	linkTypes, err := h.client.GetIssueLinkTypes(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get issue link types failed: %w", err)
+	}
+
+	return nil, linkTypes, nil
+}
+
+// linkIssuesHandler handles linking two Jira issues
+func (h *Handler) linkIssuesHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.LinkIssuesInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	err := h.client.LinkIssues(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("link issues failed: %w", err)
+	}
+
+	resultMap := types.MapOutput{"success": true}
+	return nil, resultMap, nil
+}
+
+// deleteIssueLinkHandler handles deleting an issue link
+func (h *Handler) deleteIssueLinkHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.DeleteIssueLinkInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	err := h.client.DeleteIssueLink(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("delete issue link failed: %w", err)
+	}
+
+	resultMap := types.MapOutput{"success": true}
+	return nil, resultMap, nil
+}
+
+// getRemoteLinksHandler handles getting the remote links of a Jira issue
+func (h *Handler) getRemoteLinksHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetRemoteLinksInput) (*mcp.CallToolResult, GetRemoteLinksResult, error) {
+	remoteLinks, err := h.client.GetRemoteLinks(ctx, input)
+	if err != nil {
+		return nil, GetRemoteLinksResult{}, fmt.Errorf("get remote links failed: %w", err)
+	}
+
+	result := GetRemoteLinksResult{
+		RemoteLinks: remoteLinks,
+	}
+
+	return nil, result, nil
+}
+
+// addRemoteLinkHandler handles adding a remote link to a Jira issue
+func (h *Handler) addRemoteLinkHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.AddRemoteLinkInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	remoteLink, err := h.client.AddRemoteLink(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("add remote link failed: %w", err)
+	}
+
+	return nil, remoteLink, nil
+}
+
+// AddLinkTools registers the issue link and remote link tools with the MCP server
+func AddLinkTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
+	handler := NewHandler(client)
+
+	utils.RegisterTool[types.EmptyInput, types.MapOutput](registry, "jira_get_issue_link_types", "Get the issue link types, such as Blocks or Relates, with their inward and outward descriptions", handler.getIssueLinkTypesHandler)
+	utils.RegisterTool[jira.GetRemoteLinksInput, GetRemoteLinksResult](registry, "jira_get_remote_links", "Get the remote links of a Jira issue, such as links to web pages or Confluence pages", handler.getRemoteLinksHandler)
+
+	utils.RegisterWriteTool[jira.LinkIssuesInput, types.MapOutput](registry, "jira_link_issues", "jira_link_issues", "Link two Jira issues with a link type such as Blocks or Relates", handler.linkIssuesHandler)
+	utils.RegisterWriteTool[jira.DeleteIssueLinkInput, types.MapOutput](registry, "jira_delete_issue_link", "jira_delete_issue_link", "Delete a link between two Jira issues", handler.deleteIssueLinkHandler)
+	utils.RegisterWriteTool[jira.AddRemoteLinkInput, types.MapOutput](registry, "jira_add_remote_link", "jira_add_remote_link", "Add a remote link to a Jira issue, or update the remote link with the same global ID", handler.addRemoteLinkHandler)
+}
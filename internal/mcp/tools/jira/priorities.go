@@ -26,8 +26,8 @@ func (h *Handler) getPrioritiesHandler(ctx context.Context, req *mcp.CallToolReq
 }
 
 // AddPriorityTools registers the priority-related tools with the MCP server
-func AddPriorityTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddPriorityTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[types.EmptyInput, types.MapOutput](server, "jira_get_priorities", "Get all Jira priorities", handler.getPrioritiesHandler)
+	utils.RegisterTool[types.EmptyInput, types.MapOutput](registry, "jira_get_priorities", "Get all Jira priorities", handler.getPrioritiesHandler)
 }
@@ -36,9 +36,9 @@ func (h *Handler) getProjectsHandler(ctx context.Context, req *mcp.CallToolReque
 }
 
 // AddProjectTools registers the project-related tools with the MCP server.
-func AddProjectTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddProjectTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[jira.GetProjectInput, types.MapOutput](server, "jira_get_project", "Get a specific Jira project by key", handler.getProjectHandler)
-	utils.RegisterTool[jira.GetAllProjectsInput, types.MapOutput](server, "jira_get_projects", "Get all Jira projects with optional filters", handler.getProjectsHandler)
+	utils.RegisterTool[jira.GetProjectInput, types.MapOutput](registry, "jira_get_project", "Get a specific Jira project by key", handler.getProjectHandler)
+	utils.RegisterTool[jira.GetAllProjectsInput, types.MapOutput](registry, "jira_get_projects", "Get all Jira projects with optional filters", handler.getProjectsHandler)
 }
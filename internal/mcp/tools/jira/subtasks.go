@@ -41,12 +41,10 @@ func (h *Handler) createSubTaskHandler(ctx context.Context, req *mcp.CallToolReq
 }
 
 // AddSubtaskTools registers the subtask-related tools with the MCP server
-func AddSubtaskTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddSubtaskTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[jira.GetSubtasksInput, GetSubtasksResult](server, "jira_get_subtasks", "Get subtasks for a Jira issue", handler.getSubtasksHandler)
+	utils.RegisterTool[jira.GetSubtasksInput, GetSubtasksResult](registry, "jira_get_subtasks", "Get subtasks for a Jira issue", handler.getSubtasksHandler)
 
-	if permissions["jira_create_subtask"] {
-		utils.RegisterTool[jira.CreateSubTaskInput, types.MapOutput](server, "jira_create_subtask", "Create a subtask for a Jira issue", handler.createSubTaskHandler)
-	}
+	utils.RegisterWriteTool[jira.CreateSubTaskInput, types.MapOutput](registry, "jira_create_subtask", "jira_create_subtask", "Create a subtask for a Jira issue. Set format to markdown to write the description as Markdown.", handler.createSubTaskHandler)
 }
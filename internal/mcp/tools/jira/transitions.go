@@ -33,12 +33,10 @@ func (h *Handler) transitionIssueHandler(ctx context.Context, req *mcp.CallToolR
 }
 
 // AddTransitionTools registers the transition-related tools with the MCP server
-func AddTransitionTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddTransitionTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[jira.GetTransitionsInput, types.MapOutput](server, "jira_get_transitions", "Get transitions for a Jira issue", handler.getTransitionsHandler)
+	utils.RegisterTool[jira.GetTransitionsInput, types.MapOutput](registry, "jira_get_transitions", "Get transitions for a Jira issue", handler.getTransitionsHandler)
 
-	if permissions["jira_transition_issue"] {
-		utils.RegisterTool[jira.TransitionIssueInput, types.MapOutput](server, "jira_transition_issue", "Transition a Jira issue", handler.transitionIssueHandler)
-	}
+	utils.RegisterWriteTool[jira.TransitionIssueInput, types.MapOutput](registry, "jira_transition_issue", "jira_transition_issue", "Transition a Jira issue", handler.transitionIssueHandler)
 }
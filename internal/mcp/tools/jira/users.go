@@ -56,11 +56,11 @@ func (h *Handler) searchUsersHandler(ctx context.Context, req *mcp.CallToolReque
 }
 
 // AddUserTools registers the user-related tools with the MCP server
-func AddUserTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddUserTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[types.EmptyInput, types.MapOutput](server, "jira_get_current_user", "Get the current user", handler.getCurrentUserHandler)
-	utils.RegisterTool[jira.GetUserByNameInput, types.MapOutput](server, "jira_get_user_by_name", "Get user by username", handler.getUserByNameHandler)
-	utils.RegisterTool[jira.GetUserByKeyInput, types.MapOutput](server, "jira_get_user_by_key", "Get user by key", handler.getUserByKeyHandler)
-	utils.RegisterTool[jira.SearchUsersInput, types.MapOutput](server, "jira_search_users", "Search for users", handler.searchUsersHandler)
+	utils.RegisterTool[types.EmptyInput, types.MapOutput](registry, "jira_get_current_user", "Get the current user", handler.getCurrentUserHandler)
+	utils.RegisterTool[jira.GetUserByNameInput, types.MapOutput](registry, "jira_get_user_by_name", "Get user by username", handler.getUserByNameHandler)
+	utils.RegisterTool[jira.GetUserByKeyInput, types.MapOutput](registry, "jira_get_user_by_key", "Get user by key", handler.getUserByKeyHandler)
+	utils.RegisterTool[jira.SearchUsersInput, types.MapOutput](registry, "jira_search_users", "Search for users", handler.searchUsersHandler)
 }
@@ -0,0 +1,67 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-dc-mcp-go/internal/client/jira"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+	"atlassian-dc-mcp-go/internal/types"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// getProjectVersionsHandler handles getting the versions of a Jira project
+func (h *Handler) getProjectVersionsHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetProjectVersionsInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	versions, err := h.client.GetProjectVersions(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get project versions failed: %w", err)
+	}
+
+	return nil, versions, nil
+}
+
+// createVersionHandler handles creating a Jira version
+func (h *Handler) createVersionHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.CreateVersionInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	version, err := h.client.CreateVersion(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create version failed: %w", err)
+	}
+
+	return nil, version, nil
+}
+
+// releaseVersionHandler handles releasing a Jira version
+func (h *Handler) releaseVersionHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.ReleaseVersionInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	released, err := h.client.ReleaseVersion(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("release version failed: %w", err)
+	}
+
+	resultMap := types.MapOutput{
+		"version":     released.Version,
+		"movedIssues": released.MovedIssues,
+	}
+	return nil, resultMap, nil
+}
+
+// getVersionRelatedIssueCountsHandler handles getting the issue counts of a Jira version
+func (h *Handler) getVersionRelatedIssueCountsHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetVersionRelatedIssueCountsInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	counts, err := h.client.GetVersionRelatedIssueCounts(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get version related issue counts failed: %w", err)
+	}
+
+	return nil, counts, nil
+}
+
+// AddVersionTools registers the version-related tools with the MCP server
+func AddVersionTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
+	handler := NewHandler(client)
+
+	utils.RegisterTool[jira.GetProjectVersionsInput, types.MapOutput](registry, "jira_get_project_versions", "Get the versions of a Jira project, such as fix versions, with their release state and dates", handler.getProjectVersionsHandler)
+	utils.RegisterTool[jira.GetVersionRelatedIssueCountsInput, types.MapOutput](registry, "jira_get_version_related_issue_counts", "Get the number of issues fixed in, affected by and unresolved in a Jira version", handler.getVersionRelatedIssueCountsHandler)
+
+	utils.RegisterWriteTool[jira.CreateVersionInput, types.MapOutput](registry, "jira_create_version", "jira_create_version", "Create a version in a Jira project", handler.createVersionHandler)
+	utils.RegisterWriteTool[jira.ReleaseVersionInput, types.MapOutput](registry, "jira_release_version", "jira_release_version", "Release a Jira version, optionally moving its unresolved issues to another version first", handler.releaseVersionHandler)
+}
@@ -0,0 +1,75 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-dc-mcp-go/internal/client/jira"
+	"atlassian-dc-mcp-go/internal/mcp/utils"
+	"atlassian-dc-mcp-go/internal/types"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// assignIssueHandler handles assigning a Jira issue
+func (h *Handler) assignIssueHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.AssignIssueInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	result, err := h.client.AssignIssue(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("assign issue failed: %w", err)
+	}
+
+	return nil, result, nil
+}
+
+// getWatchersHandler handles getting the watchers of a Jira issue
+func (h *Handler) getWatchersHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.GetWatchersInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	watchers, err := h.client.GetWatchers(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get watchers failed: %w", err)
+	}
+
+	return nil, watchers, nil
+}
+
+// addWatcherHandler handles adding a watcher to a Jira issue
+func (h *Handler) addWatcherHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.WatcherInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	result, err := h.client.AddWatcher(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("add watcher failed: %w", err)
+	}
+
+	return nil, result, nil
+}
+
+// removeWatcherHandler handles removing a watcher from a Jira issue
+func (h *Handler) removeWatcherHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.WatcherInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	result, err := h.client.RemoveWatcher(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("remove watcher failed: %w", err)
+	}
+
+	return nil, result, nil
+}
+
+// voteIssueHandler handles voting for a Jira issue
+func (h *Handler) voteIssueHandler(ctx context.Context, req *mcp.CallToolRequest, input jira.VoteIssueInput) (*mcp.CallToolResult, types.MapOutput, error) {
+	err := h.client.VoteIssue(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("vote issue failed: %w", err)
+	}
+
+	resultMap := types.MapOutput{"success": true}
+	return nil, resultMap, nil
+}
+
+// AddWatcherTools registers the assignment, watcher and vote tools with the MCP server
+func AddWatcherTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
+	handler := NewHandler(client)
+
+	utils.RegisterTool[jira.GetWatchersInput, types.MapOutput](registry, "jira_get_watchers", "Get the watchers of a Jira issue", handler.getWatchersHandler)
+
+	utils.RegisterWriteTool[jira.AssignIssueInput, types.MapOutput](registry, "jira_assign_issue", "jira_assign_issue", "Assign a Jira issue to a user given by username or display name, to yourself with currentUser, to the default assignee of the project with default, or to nobody with none", handler.assignIssueHandler)
+	utils.RegisterWriteTool[jira.WatcherInput, types.MapOutput](registry, "jira_add_watcher", "jira_add_watcher", "Add a watcher, given by username or display name, to a Jira issue", handler.addWatcherHandler)
+	utils.RegisterWriteTool[jira.WatcherInput, types.MapOutput](registry, "jira_remove_watcher", "jira_remove_watcher", "Remove a watcher, given by username or display name, from a Jira issue", handler.removeWatcherHandler)
+	utils.RegisterWriteTool[jira.VoteIssueInput, types.MapOutput](registry, "jira_vote_issue", "jira_vote_issue", "Vote for a Jira issue, or remove your vote", handler.voteIssueHandler)
+}
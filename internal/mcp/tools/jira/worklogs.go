@@ -32,13 +32,10 @@ func (h *Handler) addWorklogHandler(ctx context.Context, req *mcp.CallToolReques
 }
 
 // AddWorklogTools registers the worklog-related tools with the MCP server
-func AddWorklogTools(server *mcp.Server, client *jira.JiraClient, permissions map[string]bool) {
+func AddWorklogTools(registry *utils.ToolRegistry, client *jira.JiraClient) {
 	handler := NewHandler(client)
 
-	utils.RegisterTool[jira.GetWorklogsInput, types.MapOutput](server, "jira_get_worklogs", "Get worklogs for a Jira issue or a specific worklog by ID", handler.getWorklogsHandler)
+	utils.RegisterTool[jira.GetWorklogsInput, types.MapOutput](registry, "jira_get_worklogs", "Get worklogs for a Jira issue or a specific worklog by ID", handler.getWorklogsHandler)
 
-	// Check if the user has permission to add worklogs
-	if permissions["jira-add-worklogs"] {
-		utils.RegisterTool[jira.AddWorklogInput, types.MapOutput](server, "jira_add_worklog", "Add a new worklog entry to a Jira issue", handler.addWorklogHandler)
-	}
+	utils.RegisterWriteTool[jira.AddWorklogInput, types.MapOutput](registry, "jira_add_worklogs", "jira_add_worklog", "Add a new worklog entry to a Jira issue", handler.addWorklogHandler)
 }
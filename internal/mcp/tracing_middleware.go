@@ -0,0 +1,74 @@
+package mcp
+
+import (
+	"context"
+	"net/http"
+
+	"atlassian-dc-mcp-go/internal/tracing"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingMiddleware creates a middleware that starts a span for every received request.
+// It must be added last so that the span covers the whole middleware chain.
+// A trace context sent by the client in the HTTP headers or in the request's
+// _meta is continued.
+func TracingMiddleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			ctx = extractTraceContext(ctx, req)
+
+			spanName := method
+			attrs := []attribute.KeyValue{tracing.AttrMethod.String(method)}
+			if method == "tools/call" {
+				if callToolReq, ok := req.(*mcp.CallToolRequest); ok && callToolReq.Params != nil {
+					spanName = method + " " + callToolReq.Params.Name
+					attrs = append(attrs, tracing.AttrTool.String(callToolReq.Params.Name))
+				}
+			}
+
+			ctx, span := tracing.Tracer().Start(ctx, spanName,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(attrs...),
+			)
+			defer span.End()
+
+			result, err := next(ctx, method, req)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			} else if toolResult, ok := result.(*mcp.CallToolResult); ok && toolResult.IsError {
+				span.SetStatus(codes.Error, "tool call failed")
+			}
+
+			return result, err
+		}
+	}
+}
+
+// extractTraceContext reads the W3C trace context from the HTTP headers of the
+// request, falling back to the traceparent and tracestate keys of _meta
+func extractTraceContext(ctx context.Context, req mcp.Request) context.Context {
+	propagator := otel.GetTextMapPropagator()
+
+	if extra := req.GetExtra(); extra != nil && extra.Header != nil && extra.Header.Get("traceparent") != "" {
+		return propagator.Extract(ctx, propagation.HeaderCarrier(extra.Header))
+	}
+
+	if callToolReq, ok := req.(*mcp.CallToolRequest); ok && callToolReq.Params != nil {
+		carrier := propagation.HeaderCarrier(http.Header{})
+		for _, key := range []string{"traceparent", "tracestate"} {
+			if value, ok := callToolReq.Params.Meta[key].(string); ok {
+				carrier.Set(key, value)
+			}
+		}
+		return propagator.Extract(ctx, carrier)
+	}
+
+	return ctx
+}
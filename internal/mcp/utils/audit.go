@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/utils/logging"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxAuditValueLength is the length above which string arguments are truncated in the audit log
+const maxAuditValueLength = 256
+
+// sensitiveArguments are substrings of argument names whose values are never logged
+var sensitiveArguments = []string{"password", "token", "secret", "cookie", "authorization"}
+
+// entityArguments are the arguments that identify the written entity, in the
+// order they are joined into the audit entity key
+var entityArguments = []string{
+	"projectKey", "repoSlug", "spaceKey", "boardId", "sprintId",
+	"issueKey", "issueIdOrKey", "pullRequestId", "contentID", "contentId", "pageId",
+	"commentId", "attachmentId", "worklogId", "linkId", "versionId", "name",
+}
+
+// audit wraps a write tool handler to append a record of every call to the audit log.
+// Dry-run calls are not recorded since they don't modify any data.
+func audit[In, Out any](name string, handler mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		if !logging.AuditEnabled() || client.IsDryRun(ctx) {
+			return handler(ctx, req, input)
+		}
+
+		ctx, recorder := client.WithRequestRecorder(ctx)
+		start := time.Now()
+		result, output, err := handler(ctx, req, input)
+
+		args := redactArguments(input)
+		entry := logging.AuditEntry{
+			SessionID: sessionID(req),
+			Identity:  callerIdentity(ctx, name),
+			Tool:      name,
+			Arguments: args,
+			EntityKey: entityKey(args, output),
+			Duration:  time.Since(start),
+		}
+		if write, ok := recorder.LastWrite(); ok {
+			entry.Status = write.StatusCode
+		}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		logging.Audit(entry)
+
+		return result, output, err
+	}
+}
+
+// sessionID returns the ID of the MCP session of the request
+func sessionID(req *mcp.CallToolRequest) string {
+	if req == nil || req.Session == nil {
+		return ""
+	}
+	return req.Session.ID()
+}
+
+// callerIdentity hashes the credentials used for the service of the tool,
+// which is the prefix of the tool name
+func callerIdentity(ctx context.Context, tool string) string {
+	service, _, _ := strings.Cut(tool, "_")
+	key, ok := client.TokenKeyForService(service)
+	if !ok {
+		return ""
+	}
+	return client.CredentialIdentity(ctx, key)
+}
+
+// redactArguments converts the tool input into a map, replacing secrets and
+// truncating long values such as issue descriptions or page bodies
+func redactArguments(input any) map[string]any {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil
+	}
+
+	var args map[string]any
+	if err := json.Unmarshal(data, &args); err != nil {
+		return nil
+	}
+
+	redact(args)
+	return args
+}
+
+// redact rewrites a decoded JSON value in place
+func redact(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if isSensitive(key) {
+				v[key] = "[REDACTED]"
+				continue
+			}
+			if s, ok := value.(string); ok && len(s) > maxAuditValueLength {
+				v[key] = fmt.Sprintf("%s... (%d bytes)", s[:maxAuditValueLength], len(s))
+				continue
+			}
+			redact(value)
+		}
+	case []any:
+		for i, item := range v {
+			if s, ok := item.(string); ok && len(s) > maxAuditValueLength {
+				v[i] = fmt.Sprintf("%s... (%d bytes)", s[:maxAuditValueLength], len(s))
+				continue
+			}
+			redact(item)
+		}
+	}
+}
+
+// isSensitive reports whether an argument name refers to a secret
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range sensitiveArguments {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// entityKey identifies the written entity. The key of a created entity, such as
+// a Jira issue, is used as is; otherwise the identifying arguments are joined and
+// completed with the ID returned by the service.
+func entityKey(args map[string]any, output any) string {
+	var result map[string]any
+	if data, err := json.Marshal(output); err == nil {
+		_ = json.Unmarshal(data, &result)
+	}
+
+	if key, ok := result["key"].(string); ok && key != "" {
+		return key
+	}
+
+	var parts []string
+	for _, name := range entityArguments {
+		if value, ok := args[name]; ok && value != nil && value != "" {
+			parts = append(parts, fmt.Sprint(value))
+		}
+	}
+
+	if id, ok := result["id"]; ok && id != nil {
+		idString := fmt.Sprint(id)
+		if len(parts) == 0 || parts[len(parts)-1] != idString {
+			parts = append(parts, idString)
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"atlassian-dc-mcp-go/internal/client"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// dryRunArgument is the argument that every write tool accepts to only plan its requests
+const dryRunArgument = "dryRun"
+
+// DryRunResult is returned by a write tool instead of its result in dry-run mode
+type DryRunResult struct {
+	DryRun   bool                    `json:"dryRun"`
+	Requests []client.PlannedRequest `json:"requests"`
+}
+
+// writeToolSchema infers the input schema of a write tool and adds the dryRun argument
+func writeToolSchema[In any]() (*jsonschema.Schema, error) {
+	schema, err := jsonschema.For[In](&jsonschema.ForOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if schema.Properties == nil {
+		schema.Properties = make(map[string]*jsonschema.Schema)
+	}
+	schema.Properties[dryRunArgument] = &jsonschema.Schema{
+		Type:        "boolean",
+		Description: "Return the requests that would be sent without executing them. Lookups and validations are still performed.",
+	}
+	return schema, nil
+}
+
+// dryRun wraps a write tool handler to plan its write requests instead of sending
+// them when the server runs in dry-run mode or the call sets the dryRun argument.
+// The plan replaces the structured content of the result, so the wrapped tool
+// has no output type and declares no output schema.
+func dryRun[In, Out any](handler mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, any] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, any, error) {
+		if !client.DryRunEnabled() && !dryRunRequested(req) {
+			result, output, err := handler(ctx, req, input)
+			if err != nil {
+				return nil, nil, err
+			}
+			return result, output, nil
+		}
+
+		ctx, plan := client.WithDryRun(ctx)
+		if _, _, err := handler(ctx, req, input); err != nil {
+			return nil, nil, err
+		}
+
+		output := DryRunResult{DryRun: true, Requests: plan.Requests()}
+		data, err := json.Marshal(output)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode dry-run result: %w", err)
+		}
+
+		return &mcp.CallToolResult{
+			Content:           []mcp.Content{&mcp.TextContent{Text: string(data)}},
+			StructuredContent: output,
+		}, nil, nil
+	}
+}
+
+// dryRunRequested reports whether the dryRun argument of a tool call is true
+func dryRunRequested(req *mcp.CallToolRequest) bool {
+	if req == nil || req.Params == nil || req.Params.Arguments == nil {
+		return false
+	}
+
+	var args struct {
+		DryRun bool `json:"dryRun"`
+	}
+	if err := json.Unmarshal(req.Params.Arguments, &args); err != nil {
+		return false
+	}
+	return args.DryRun
+}
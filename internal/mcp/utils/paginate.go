@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"context"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// paginate wraps a list tool handler to fetch all pages and merge them when the
+// input is pageable and sets fetchAll. Handlers with other outputs than a
+// map page their results themselves.
+func paginate[In, Out any](handler mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	var zero Out
+	if _, ok := any(zero).(types.MapOutput); !ok {
+		return handler
+	}
+	if _, ok := any(new(In)).(client.Pageable); !ok {
+		return handler
+	}
+
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		pageable := any(&input).(client.Pageable)
+		fetchAll, maxItems := pageable.FetchAllPages()
+		if !fetchAll {
+			return handler(ctx, req, input)
+		}
+
+		merged, err := client.FetchAll(ctx, pageable.PagingStyle(), pageable.StartPage(), maxItems, func(ctx context.Context, page client.Page) (types.MapOutput, error) {
+			pageInput := input
+			any(&pageInput).(client.Pageable).SetPage(page)
+			_, output, err := handler(ctx, req, pageInput)
+			if err != nil {
+				return nil, err
+			}
+			return any(output).(types.MapOutput), nil
+		})
+		if err != nil {
+			return nil, zero, err
+		}
+
+		return nil, any(merged).(Out), nil
+	}
+}
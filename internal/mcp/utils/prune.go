@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"atlassian-dc-mcp-go/internal/client"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// pruneProfileArgument is the argument of every tool that selects the prune profile of its responses
+const pruneProfileArgument = "pruneProfile"
+
+// addPruneProfileArgument adds the pruneProfile argument to the input schema of a tool
+func addPruneProfileArgument(schema *jsonschema.Schema) {
+	if schema.Properties == nil {
+		schema.Properties = make(map[string]*jsonschema.Schema)
+	}
+	schema.Properties[pruneProfileArgument] = &jsonschema.Schema{
+		Type:        "string",
+		Description: "How much to prune the responses of this call: default, minimal (only empty values, avatars and email addresses are removed), raw (nothing is removed) or a profile configured on the server. Defaults to the profile configured for the tool or service.",
+	}
+}
+
+// withPruneProfile wraps a tool handler to prune its responses with the profile
+// of the pruneProfile argument of the call, or else the profile configured for
+// the tool.
+func withPruneProfile[In, Out any](name string, handler mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		profile := requestedPruneProfile(req)
+		if profile == "" {
+			profile = client.ToolPruneProfile(name)
+		} else if !client.PruneProfileExists(profile) {
+			var zero Out
+			return nil, zero, fmt.Errorf("unknown prune profile %q, valid options are: %s", profile, strings.Join(client.PruneProfileNames(), ", "))
+		}
+
+		if profile != "" {
+			ctx = client.WithPruneProfile(ctx, profile)
+		}
+		return handler(ctx, req, input)
+	}
+}
+
+// requestedPruneProfile returns the pruneProfile argument of a tool call
+func requestedPruneProfile(req *mcp.CallToolRequest) string {
+	if req == nil || req.Params == nil || req.Params.Arguments == nil {
+		return ""
+	}
+
+	var args struct {
+		PruneProfile string `json:"pruneProfile"`
+	}
+	if err := json.Unmarshal(req.Params.Arguments, &args); err != nil {
+		return ""
+	}
+	return args.PruneProfile
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+
+	"atlassian-dc-mcp-go/internal/client"
+	"atlassian-dc-mcp-go/internal/types"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// selectArgument is the argument of tools with a map result that projects the result
+const selectArgument = "select"
+
+// selectable reports whether the result of a tool can be projected with select
+func selectable[Out any]() bool {
+	var zero Out
+	_, ok := any(zero).(types.MapOutput)
+	return ok
+}
+
+// addSelectArgument adds the select argument to the input schema of a tool
+func addSelectArgument(schema *jsonschema.Schema) {
+	if schema.Properties == nil {
+		schema.Properties = make(map[string]*jsonschema.Schema)
+	}
+	schema.Properties[selectArgument] = &jsonschema.Schema{
+		Type:        "array",
+		Items:       &jsonschema.Schema{Type: "string"},
+		Description: "Return only these fields of the result, as dot-separated paths such as key or fields.status.name. Arrays are projected element by element, so issues.key returns the key of every issue.",
+	}
+}
+
+// project wraps a tool handler with a map result to return only the fields
+// selected by the select argument of the call.
+func project[In, Out any](handler mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	if !selectable[Out]() {
+		return handler
+	}
+
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		result, output, err := handler(ctx, req, input)
+		paths := selectedPaths(req)
+		if err != nil || len(paths) == 0 {
+			return result, output, err
+		}
+
+		projected := client.Project(any(output).(types.MapOutput), paths)
+		return result, any(projected).(Out), nil
+	}
+}
+
+// selectedPaths returns the paths of the select argument of a tool call
+func selectedPaths(req *mcp.CallToolRequest) []string {
+	if req == nil || req.Params == nil || req.Params.Arguments == nil {
+		return nil
+	}
+
+	var args struct {
+		Select []string `json:"select"`
+	}
+	if err := json.Unmarshal(req.Params.Arguments, &args); err != nil {
+		return nil
+	}
+	return args.Select
+}
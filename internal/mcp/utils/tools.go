@@ -2,19 +2,166 @@
 package utils
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"atlassian-dc-mcp-go/internal/metrics"
+	"atlassian-dc-mcp-go/internal/tracing"
+	"atlassian-dc-mcp-go/internal/utils/logging"
+
+	"github.com/google/jsonschema-go/jsonschema"
 	mcp "github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
-// RegisterTool is a helper function that simplifies the registration of MCP tools.
+// ToolRegistration describes a tool that has been collected by a ToolRegistry
+// but not necessarily added to an MCP server yet.
+type ToolRegistration struct {
+	// Name is the tool name exposed to MCP clients.
+	Name string
+	// Permission is the legacy permission key guarding a write tool.
+	// It is empty for read-only tools.
+	Permission string
+
+	add func(server *mcp.Server)
+}
+
+// ReadOnly reports whether the tool only reads data.
+func (t ToolRegistration) ReadOnly() bool {
+	return t.Permission == ""
+}
+
+// AddTo adds the tool to the given MCP server.
+func (t ToolRegistration) AddTo(server *mcp.Server) {
+	t.add(server)
+}
+
+// ToolRegistry collects tool definitions so that a permission policy can be
+// applied to every tool name before the tools are added to an MCP server.
+type ToolRegistry struct {
+	tools []ToolRegistration
+}
+
+// NewToolRegistry creates an empty ToolRegistry.
+func NewToolRegistry() *ToolRegistry {
+	return &ToolRegistry{}
+}
+
+// Tools returns the collected tools in registration order.
+func (r *ToolRegistry) Tools() []ToolRegistration {
+	return r.tools
+}
+
+// RegisterTool is a helper function that simplifies the registration of read-only MCP tools.
 // It reduces boilerplate code by automatically creating the tool definition with
-// the provided name and description.
+// the provided name and description. List tools with a pageable input and a map
+// result fetch and merge all pages when the call sets fetchAll, and tools with a
+// map result accept a select argument that projects the result. Every tool
+// accepts a pruneProfile argument that selects how its responses are pruned.
+//
+// A tool whose input schema cannot be generated is not registered and the error
+// is logged.
+//
+// Example usage:
+//
+//	RegisterTool(registry, "jira_get_issue", "Get a specific Jira issue by its key", handler.getIssueHandler)
+func RegisterTool[In, Out any](registry *ToolRegistry, name, description string, handler mcp.ToolHandlerFor[In, Out]) {
+	if err := registerTool(registry, name, description, handler); err != nil {
+		logging.GetLogger().Error("Failed to register tool", zap.String("tool", name), zap.Error(err))
+	}
+}
+
+func registerTool[In, Out any](registry *ToolRegistry, name, description string, handler mcp.ToolHandlerFor[In, Out]) error {
+	schema, err := jsonschema.For[In](&jsonschema.ForOptions{})
+	if err != nil {
+		return fmt.Errorf("tool %q: input schema: %w", name, err)
+	}
+	if selectable[Out]() {
+		addSelectArgument(schema)
+	}
+	addPruneProfileArgument(schema)
+
+	tool := &mcp.Tool{
+		Name:        name,
+		Description: description,
+		InputSchema: schema,
+	}
+	wrapped := instrument(name, withPruneProfile(name, project(paginate(handler))))
+
+	registry.tools = append(registry.tools, ToolRegistration{
+		Name: name,
+		add: func(server *mcp.Server) {
+			mcp.AddTool(server, tool, wrapped)
+		},
+	})
+	return nil
+}
+
+// RegisterWriteTool registers a tool that modifies data. Write tools are disabled
+// unless the legacy permission key is enabled or a policy rule allows them.
+// Calls of write tools are recorded in the audit log when it is enabled, and
+// write tools accept a dryRun argument that returns the planned requests instead
+// of executing them. Because a dry run returns the plan as structured content,
+// write tools declare no output schema. Write tools accept the pruneProfile
+// argument of read tools, and the select argument when they have a map result.
+//
+// A tool whose input schema cannot be generated is not registered and the error
+// is logged.
 //
 // Example usage:
 //
-//	registerTool(server, "jira_get_issue", "Get a specific Jira issue by its key", handler.getIssueHandler)
-func RegisterTool[In, Out any](server *mcp.Server, name, description string, handler mcp.ToolHandlerFor[In, Out]) {
-	mcp.AddTool[In, Out](server, &mcp.Tool{
+//	RegisterWriteTool(registry, "jira_create_issue", "jira_create_issue", "Create a new Jira issue", handler.createIssueHandler)
+func RegisterWriteTool[In, Out any](registry *ToolRegistry, permission, name, description string, handler mcp.ToolHandlerFor[In, Out]) {
+	if err := registerWriteTool(registry, permission, name, description, handler); err != nil {
+		logging.GetLogger().Error("Failed to register tool", zap.String("tool", name), zap.Error(err))
+	}
+}
+
+func registerWriteTool[In, Out any](registry *ToolRegistry, permission, name, description string, handler mcp.ToolHandlerFor[In, Out]) error {
+	schema, err := writeToolSchema[In]()
+	if err != nil {
+		return fmt.Errorf("tool %q: input schema: %w", name, err)
+	}
+	if selectable[Out]() {
+		addSelectArgument(schema)
+	}
+	addPruneProfileArgument(schema)
+
+	tool := &mcp.Tool{
 		Name:        name,
 		Description: description,
-	}, handler)
-}
\ No newline at end of file
+		InputSchema: schema,
+	}
+	wrapped := instrument(name, withPruneProfile(name, dryRun(project(audit(name, handler)))))
+
+	registry.tools = append(registry.tools, ToolRegistration{
+		Name:       name,
+		Permission: permission,
+		add: func(server *mcp.Server) {
+			mcp.AddTool(server, tool, wrapped)
+		},
+	})
+	return nil
+}
+
+// instrument wraps a tool handler to trace it and record call counts, latency and errors.
+func instrument[In, Out any](name string, handler mcp.ToolHandlerFor[In, Out]) mcp.ToolHandlerFor[In, Out] {
+	return func(ctx context.Context, req *mcp.CallToolRequest, input In) (*mcp.CallToolResult, Out, error) {
+		ctx, span := tracing.Tracer().Start(ctx, name, trace.WithAttributes(tracing.AttrTool.String(name)))
+		defer span.End()
+
+		start := time.Now()
+		result, output, err := handler(ctx, req, input)
+		metrics.ObserveToolCall(name, time.Since(start), err)
+
+		if err != nil {
+			span.SetAttributes(tracing.AttrErrorCode.String(metrics.ErrorCode(err)))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return result, output, err
+	}
+}
@@ -0,0 +1,137 @@
+// Package metrics provides the Prometheus metrics of the MCP server.
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"atlassian-dc-mcp-go/internal/types"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes all metric names
+const namespace = "atlassian_mcp"
+
+var (
+	registry = prometheus.NewRegistry()
+
+	toolCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tool_calls_total",
+		Help:      "Number of tool calls by tool.",
+	}, []string{"tool"})
+
+	toolDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "tool_call_duration_seconds",
+		Help:      "Duration of tool calls by tool.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"tool"})
+
+	toolErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tool_errors_total",
+		Help:      "Number of failed tool calls by tool and error code.",
+	}, []string{"tool", "code"})
+
+	upstreamRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upstream_requests_total",
+		Help:      "Number of requests to Atlassian services by service, method and status code.",
+	}, []string{"service", "method", "status"})
+
+	upstreamDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "upstream_request_duration_seconds",
+		Help:      "Duration of requests to Atlassian services, including retries, by service and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "method"})
+
+	upstreamRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "upstream_retries_total",
+		Help:      "Number of retried requests to Atlassian services by service.",
+	}, []string{"service"})
+
+	responseBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "response_bytes_total",
+		Help:      "Size of decoded Atlassian responses before pruning by service.",
+	}, []string{"service"})
+
+	pruneSavedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "prune_saved_bytes_total",
+		Help:      "Number of response bytes removed by pruning by service.",
+	}, []string{"service"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		toolCalls,
+		toolDuration,
+		toolErrors,
+		upstreamRequests,
+		upstreamDuration,
+		upstreamRetries,
+		responseBytes,
+		pruneSavedBytes,
+	)
+}
+
+// Handler returns the HTTP handler that serves the metrics in the Prometheus format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// ObserveToolCall records a tool call. Failed calls are counted by the code of
+// the types.Error they wrap.
+func ObserveToolCall(tool string, duration time.Duration, err error) {
+	toolCalls.WithLabelValues(tool).Inc()
+	toolDuration.WithLabelValues(tool).Observe(duration.Seconds())
+
+	if err != nil {
+		toolErrors.WithLabelValues(tool, ErrorCode(err)).Inc()
+	}
+}
+
+// ErrorCode returns the code of the types.Error wrapped by err, or INTERNAL_ERROR
+func ErrorCode(err error) string {
+	var typedErr *types.Error
+	if errors.As(err, &typedErr) && typedErr.Code != "" {
+		return typedErr.Code
+	}
+	return "INTERNAL_ERROR"
+}
+
+// ObserveUpstreamRequest records a request to an Atlassian service. A status code
+// of 0 means that no response was received.
+func ObserveUpstreamRequest(service, method string, statusCode int, duration time.Duration) {
+	status := "error"
+	if statusCode > 0 {
+		status = strconv.Itoa(statusCode)
+	}
+
+	upstreamRequests.WithLabelValues(service, method, status).Inc()
+	upstreamDuration.WithLabelValues(service, method).Observe(duration.Seconds())
+}
+
+// ObserveRetry records a retried request to an Atlassian service
+func ObserveRetry(service string) {
+	upstreamRetries.WithLabelValues(service).Inc()
+}
+
+// ObservePrune records the size of a response before and after pruning
+func ObservePrune(service string, before, after int) {
+	responseBytes.WithLabelValues(service).Add(float64(before))
+	if saved := before - after; saved > 0 {
+		pruneSavedBytes.WithLabelValues(service).Add(float64(saved))
+	}
+}
@@ -0,0 +1,111 @@
+package tracing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// fileClient is an OTLP client that appends each export request to a file as a
+// line of OTLP/JSON, the format read by the OpenTelemetry Collector's file receiver.
+type fileClient struct {
+	path string
+
+	mu   sync.Mutex
+	file *os.File
+}
+
+// newFileClient creates an OTLP client that writes to the file at path
+func newFileClient(path string) *fileClient {
+	return &fileClient{path: path}
+}
+
+// Start opens the trace file
+func (c *fileClient) Start(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	file, err := os.OpenFile(c.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open trace file: %w", err)
+	}
+	c.file = file
+	return nil
+}
+
+// Stop closes the trace file
+func (c *fileClient) Stop(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return nil
+	}
+	err := c.file.Close()
+	c.file = nil
+	return err
+}
+
+// UploadTraces writes the spans as a single OTLP/JSON line
+func (c *fileClient) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	data, err := marshalOTLPJSON(&collectorpb.ExportTraceServiceRequest{ResourceSpans: protoSpans})
+	if err != nil {
+		return fmt.Errorf("failed to encode spans: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		return fmt.Errorf("trace file is closed")
+	}
+	_, err = c.file.Write(append(data, '\n'))
+	return err
+}
+
+// idFields are the OTLP fields that hold trace and span IDs
+var idFields = map[string]bool{"traceId": true, "spanId": true, "parentSpanId": true}
+
+// marshalOTLPJSON encodes the request as OTLP/JSON. Unlike the canonical protobuf
+// JSON mapping, OTLP/JSON encodes trace and span IDs as hex instead of base64.
+func marshalOTLPJSON(req *collectorpb.ExportTraceServiceRequest) ([]byte, error) {
+	data, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	hexIDs(doc)
+	return json.Marshal(doc)
+}
+
+// hexIDs re-encodes the base64 trace and span IDs of a decoded JSON document as hex
+func hexIDs(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok && idFields[key] {
+				if id, err := base64.StdEncoding.DecodeString(s); err == nil {
+					v[key] = hex.EncodeToString(id)
+				}
+				continue
+			}
+			hexIDs(value)
+		}
+	case []any:
+		for _, item := range v {
+			hexIDs(item)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Package tracing provides OpenTelemetry tracing for MCP tool calls and
+// the requests they send to the Atlassian services.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"atlassian-dc-mcp-go/internal/config"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName identifies the spans created by this server
+const instrumentationName = "atlassian-dc-mcp-go"
+
+// Span attribute keys shared by the tracing instrumentation
+const (
+	AttrTool       = attribute.Key("mcp.tool.name")
+	AttrMethod     = attribute.Key("mcp.method.name")
+	AttrService    = attribute.Key("atlassian.service")
+	AttrErrorCode  = attribute.Key("error.code")
+	AttrRetryCount = attribute.Key("http.request.resend_count")
+	AttrCacheHit   = attribute.Key("atlassian.cache.hit")
+)
+
+// Tracer returns the tracer used by the server. It is a no-op tracer
+// unless tracing has been initialized.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Init configures the global tracer provider and the W3C trace context propagator.
+// The returned function flushes and stops the exporter.
+func Init(ctx context.Context, cfg config.TracingConfig, version string) (func(context.Context) error, error) {
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var client otlptrace.Client
+	switch cfg.Exporter {
+	case config.TracingExporterFile:
+		client = newFileClient(cfg.FilePath)
+	default:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(cfg.Endpoint)}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+		client = otlptracehttp.NewClient(opts...)
+	}
+
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", cfg.ServiceName),
+		attribute.String("service.version", version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	return provider.Shutdown, nil
+}
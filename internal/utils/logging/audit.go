@@ -0,0 +1,95 @@
+package logging
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+var auditLogger *zap.Logger
+
+// AuditEntry is a single record of the audit log
+type AuditEntry struct {
+	// SessionID is the MCP session the tool was called in
+	SessionID string
+	// Identity is a hash of the caller's credentials
+	Identity string
+	Tool     string
+	// Arguments are the tool arguments with secrets redacted
+	Arguments map[string]any
+	// Status is the HTTP status code of the upstream write request
+	Status int
+	Error  string
+	// EntityKey identifies the entity that was written, e.g. an issue key
+	EntityKey string
+	Duration  time.Duration
+}
+
+// InitAuditLogger opens the append-only JSONL audit log. The audit log is kept
+// separate from the application log and has its own rotation settings.
+func InitAuditLogger(cfg *AuditConfig) {
+	if cfg == nil || cfg.FilePath == "" {
+		auditLogger = nil
+		return
+	}
+
+	encoderConfig := zapcore.EncoderConfig{
+		TimeKey:        "timestamp",
+		MessageKey:     zapcore.OmitKey,
+		LevelKey:       zapcore.OmitKey,
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeTime:     zapcore.RFC3339NanoTimeEncoder,
+		EncodeDuration: zapcore.StringDurationEncoder,
+	}
+
+	writer := zapcore.AddSync(&lumberjack.Logger{
+		Filename:   cfg.FilePath,
+		MaxSize:    cfg.MaxSize,
+		MaxAge:     cfg.MaxAge,
+		MaxBackups: cfg.MaxBackups,
+		Compress:   cfg.Compress,
+	})
+
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), zapcore.Lock(writer), zapcore.InfoLevel)
+	auditLogger = zap.New(core)
+}
+
+// AuditEnabled reports whether the audit log is enabled
+func AuditEnabled() bool {
+	return auditLogger != nil
+}
+
+// Audit appends an entry to the audit log
+func Audit(entry AuditEntry) {
+	if auditLogger == nil {
+		return
+	}
+
+	fields := []zap.Field{
+		zap.String("tool", entry.Tool),
+		zap.String("sessionId", entry.SessionID),
+		zap.String("identity", entry.Identity),
+		zap.Any("arguments", entry.Arguments),
+	}
+	if entry.Status > 0 {
+		fields = append(fields, zap.Int("status", entry.Status))
+	}
+	if entry.EntityKey != "" {
+		fields = append(fields, zap.String("entityKey", entry.EntityKey))
+	}
+	if entry.Error != "" {
+		fields = append(fields, zap.String("error", entry.Error))
+	}
+	fields = append(fields, zap.Duration("duration", entry.Duration))
+
+	auditLogger.Info("", fields...)
+}
+
+// SyncAudit flushes the audit log
+func SyncAudit() {
+	if auditLogger != nil {
+		_ = auditLogger.Sync()
+	}
+}
@@ -1,9 +1,20 @@
 package logging
 
 type Config struct {
-	Development bool   `mapstructure:"development"`
-	Level       string `mapstructure:"level"`
-	FilePath    string `mapstructure:"file_path"`
-	FileLevel   string `mapstructure:"file_level"`
-	LogThreshold int  `mapstructure:"log_threshold"` // Threshold in milliseconds for logging slow operations
-}
\ No newline at end of file
+	Development  bool        `mapstructure:"development"`
+	Level        string      `mapstructure:"level"`
+	FilePath     string      `mapstructure:"file_path"`
+	FileLevel    string      `mapstructure:"file_level"`
+	LogThreshold int         `mapstructure:"log_threshold"` // Threshold in milliseconds for logging slow operations
+	Audit        AuditConfig `mapstructure:"audit"`
+}
+
+// AuditConfig configures the audit log of write operations
+type AuditConfig struct {
+	// FilePath is the audit log file; auditing is disabled if empty
+	FilePath   string `mapstructure:"file_path"`
+	MaxSize    int    `mapstructure:"max_size"`    // megabytes before the file is rotated
+	MaxAge     int    `mapstructure:"max_age"`     // days to keep rotated files
+	MaxBackups int    `mapstructure:"max_backups"` // number of rotated files to keep
+	Compress   bool   `mapstructure:"compress"`
+}